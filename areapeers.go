@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AreapeersParam struct {
+	Offset    int64  `form:"offset"`
+	Limit     int64  `form:"limit"`
+	SinceTime string `form:"since_time"`
+	UntilTime string `form:"until_time"`
+	Lang      string `form:"lang"`
+}
+
+func (p *AreapeersParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseTimeRange(p.SinceTime, p.UntilTime)
+	return invalidParams
+}
+
+// timeRange は検索する期間を返す. 省略した場合は直近 24 時間とする.
+func (p *AreapeersParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+func getLatestAreapeers(c *gin.Context) {
+	var langParam LangParam
+	if !bindQuery(c, &langParam) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	var result bson.M
+	err := historyCollection.FindOne(ctx, bson.D{{"code", 555}}, options.FindOne().SetSort(bson.D{{"time", -1}})).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		respondProblem(c, 404, "no areapeers found")
+		return
+	}
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	summary := enrichAreapeers(result)
+	if langParam.Lang == langEnglish {
+		localizeAreapeers(summary)
+	}
+	respond(c, 200, summary, &pb.AreapeersSummary{})
+}
+
+func searchAreapeers(c *gin.Context) {
+	var areapeersParam AreapeersParam
+	if !bindQuery(c, &areapeersParam) {
+		return
+	}
+	since, until := areapeersParam.timeRange()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	limit := areapeersParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	offset := areapeersParam.Offset
+	options := options.FindOptions{Limit: &limit, Skip: &offset, Sort: bson.D{{"time", -1}}}
+
+	filters := bson.D{
+		{"code", 555},
+		{"time", bson.D{{"$gte", since.Format(recordTimeLayout)}, {"$lte", until.Format(recordTimeLayout)}}},
+	}
+	cur, err := historyCollection.Find(ctx, filters, &options)
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	records := make([]bson.M, 0)
+	if err := cur.All(ctx, &records); err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	items := make([]primitive.M, 0, len(records))
+	for _, record := range records {
+		summary := enrichAreapeers(record)
+		if areapeersParam.Lang == langEnglish {
+			localizeAreapeers(summary)
+		}
+		items = append(items, summary)
+	}
+
+	respond(c, 200, items, &pb.AreapeersSummaries{})
+}
+
+// enrichAreapeers は地域ごとのピア数に地域名と代表点を付け、地方・都道府県ごとの合計と総数を加える.
+// 地域一覧にない地域コードは総数のみに数える.
+func enrichAreapeers(record bson.M) primitive.M {
+	areas := []primitive.M{}
+	regions := map[string]int{}
+	prefs := map[string]int{}
+	total := 0
+
+	for _, entry := range recordArray(record, "areas") {
+		code, _ := recordInt(entry, "id")
+		peer, _ := recordInt(entry, "peer")
+		total += peer
+
+		item := primitive.M{"id": code, "peer": peer}
+		if area, ok := userquake.Areas.ByCode(code); ok {
+			item["region"] = area.Region
+			item["prefecture"] = area.Prefecture
+			item["name"] = area.Name
+			regions[area.Region] += peer
+			prefs[area.Prefecture] += peer
+		}
+		if centroid, ok := userquake.Centroid(code); ok {
+			item["latitude"] = centroid.Latitude
+			item["longitude"] = centroid.Longitude
+		}
+		areas = append(areas, item)
+	}
+
+	return primitive.M{
+		"id":      recordID(record),
+		"code":    555,
+		"time":    recordString(record, "time"),
+		"total":   total,
+		"areas":   areas,
+		"regions": regions,
+		"prefs":   prefs,
+	}
+}
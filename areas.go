@@ -0,0 +1,136 @@
+package main
+
+import (
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+)
+
+type AreaParam struct {
+	Region     string `form:"region"`
+	Prefecture string `form:"prefecture"`
+	Lang       string `form:"lang"`
+}
+
+// areaLabel は地域に、 lang=en の場合の英語表記を加えたもの.
+type areaLabel struct {
+	userquake.Area
+	RegionEn     string `json:"region_en,omitempty"`
+	PrefectureEn string `json:"prefecture_en,omitempty"`
+	NameEn       string `json:"name_en,omitempty"`
+}
+
+func newAreaLabel(area userquake.Area, lang string) areaLabel {
+	label := areaLabel{Area: area}
+	if lang == langEnglish {
+		label.RegionEn, _ = userquake.EnglishRegion(area.Region)
+		label.PrefectureEn, _ = userquake.EnglishPrefecture(area.Prefecture)
+		label.NameEn, _ = userquake.EnglishName(area.Code)
+	}
+	return label
+}
+
+func getAreas(c *gin.Context) {
+	var areaParam AreaParam
+	if !bindQuery(c, &areaParam) {
+		return
+	}
+
+	labels := []areaLabel{}
+	for _, area := range filterAreas(areaParam) {
+		labels = append(labels, newAreaLabel(area, areaParam.Lang))
+	}
+	respond(c, 200, labels, &pb.Areas{})
+}
+
+func filterAreas(areaParam AreaParam) []userquake.Area {
+	areas := []userquake.Area{}
+	userquake.Areas.Each(func(area userquake.Area) bool {
+		if areaParam.Region != "" && area.Region != areaParam.Region {
+			return true
+		}
+		if areaParam.Prefecture != "" && area.Prefecture != areaParam.Prefecture {
+			return true
+		}
+		areas = append(areas, area)
+		return true
+	})
+	return areas
+}
+
+// areaBoundaries は地域の境界. AREA_BOUNDARIES_FILE を指定しなければ、同梱の近似的な境界を用いる.
+var areaBoundaries = userquake.ApproximateBoundaries()
+
+// areaBoundariesApproximate は areaBoundaries が同梱の近似的な境界かどうか.
+var areaBoundariesApproximate = true
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string      `json:"type"`
+	Geometry   interface{} `json:"geometry"`
+	Properties areaFeature `json:"properties"`
+}
+
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type areaFeature struct {
+	areaLabel
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Approximate bool    `json:"approximate,omitempty"`
+}
+
+func loadAreaBoundaries(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	boundaries, err := userquake.LoadBoundaries(file)
+	if err != nil {
+		return err
+	}
+	areaBoundaries = boundaries
+	areaBoundariesApproximate = false
+	return nil
+}
+
+func getAreasGeoJSON(c *gin.Context) {
+	var areaParam AreaParam
+	if !bindQuery(c, &areaParam) {
+		return
+	}
+
+	collection := geoJSONFeatureCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	for _, area := range filterAreas(areaParam) {
+		centroid, ok := userquake.Centroid(area.Code)
+		if !ok {
+			continue
+		}
+
+		properties := areaFeature{areaLabel: newAreaLabel(area, areaParam.Lang), Latitude: centroid.Latitude, Longitude: centroid.Longitude}
+		var geometry interface{} = geoJSONPoint{Type: "Point", Coordinates: [2]float64{centroid.Longitude, centroid.Latitude}}
+		if boundary, ok := areaBoundaries[area.Code]; ok {
+			geometry = boundary
+			properties.Approximate = areaBoundariesApproximate
+		}
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geometry,
+			Properties: properties,
+		})
+	}
+
+	c.Header("Content-Type", "application/geo+json; charset=utf-8")
+	c.JSON(200, collection)
+}
@@ -0,0 +1,153 @@
+// genboundaries は地域の代表点から、地域ごとの近似的な境界 (GeoJSON) を作成します.
+//
+//	go run ./cmd/genboundaries -o userquake/approximate_boundaries.geojson
+//
+// 境界は代表点のボロノイ分割を、代表点から -radius km 以内に制限したものです.
+// 実際の地域の境界ではなく、海上にも広がるため、地図上でおおよその範囲を塗り分ける用途に限ります.
+// 正確な境界が必要な場合は、気象庁の予報区等 GIS データなどから作成した GeoJSON を AREA_BOUNDARIES_FILE で指定します.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"math"
+	"os"
+
+	"github.com/p2pquake/web-api-v2/userquake"
+)
+
+// referenceLatitude は経度方向の距離を求めるときの基準の緯度. 日本のおおよその中央.
+const referenceLatitude = 36.0
+
+const (
+	kmPerLatitude  = 110.57
+	kmPerLongitude = 111.32
+)
+
+type point struct {
+	X float64
+	Y float64
+}
+
+type site struct {
+	Code  int
+	Point point
+}
+
+type featureCollection struct {
+	Type     string    `json:"type"`
+	Features []feature `json:"features"`
+}
+
+type feature struct {
+	Type       string            `json:"type"`
+	Geometry   polygon           `json:"geometry"`
+	Properties featureProperties `json:"properties"`
+}
+
+type polygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+type featureProperties struct {
+	Code int `json:"code"`
+}
+
+func main() {
+	output := flag.String("o", "userquake/approximate_boundaries.geojson", "output path")
+	radius := flag.Float64("radius", 80, "maximum distance from the centroid (km)")
+	vertices := flag.Int("vertices", 32, "number of vertices of the circle limiting each cell")
+	flag.Parse()
+
+	var sites []site
+	userquake.Areas.Each(func(area userquake.Area) bool {
+		if centroid, ok := userquake.Centroid(area.Code); ok {
+			sites = append(sites, site{Code: area.Code, Point: project(centroid)})
+		}
+		return true
+	})
+
+	collection := featureCollection{Type: "FeatureCollection", Features: []feature{}}
+	for _, s := range sites {
+		cell := circle(s.Point, *radius, *vertices)
+		for _, other := range sites {
+			if other.Code == s.Code || other.Point == s.Point {
+				continue
+			}
+			cell = clip(cell, s.Point, other.Point)
+		}
+		if len(cell) < 3 {
+			log.Fatalf("area %d: empty cell", s.Code)
+		}
+
+		ring := make([][2]float64, 0, len(cell)+1)
+		for _, p := range cell {
+			ring = append(ring, unproject(p))
+		}
+		ring = append(ring, ring[0])
+		collection.Features = append(collection.Features, feature{
+			Type:       "Feature",
+			Geometry:   polygon{Type: "Polygon", Coordinates: [][][2]float64{ring}},
+			Properties: featureProperties{Code: s.Code},
+		})
+	}
+
+	b, err := json.Marshal(collection)
+	if err != nil {
+		log.Fatalf("marshal error: %v", err)
+	}
+	if err := os.WriteFile(*output, append(b, '\n'), 0644); err != nil {
+		log.Fatalf("write error: %v", err)
+	}
+	log.Printf("%d areas written to %s", len(collection.Features), *output)
+}
+
+// project は緯度・経度を、基準の緯度での正距円筒図法の平面座標 (km) に変換する.
+func project(c userquake.Coordinate) point {
+	return point{
+		X: c.Longitude * kmPerLongitude * math.Cos(referenceLatitude*math.Pi/180),
+		Y: c.Latitude * kmPerLatitude,
+	}
+}
+
+// unproject は平面座標を GeoJSON の座標 (経度, 緯度) に戻す. 小数第 3 位 (約 100 m) に丸める.
+func unproject(p point) [2]float64 {
+	longitude := p.X / (kmPerLongitude * math.Cos(referenceLatitude*math.Pi/180))
+	latitude := p.Y / kmPerLatitude
+	return [2]float64{math.Round(longitude*1000) / 1000, math.Round(latitude*1000) / 1000}
+}
+
+// circle は center を中心とする半径 radius の正多角形を反時計回りに返す.
+func circle(center point, radius float64, vertices int) []point {
+	points := make([]point, 0, vertices)
+	for i := 0; i < vertices; i++ {
+		angle := 2 * math.Pi * float64(i) / float64(vertices)
+		points = append(points, point{center.X + radius*math.Cos(angle), center.Y + radius*math.Sin(angle)})
+	}
+	return points
+}
+
+// clip は多角形のうち、 other より site に近い側を返す (Sutherland-Hodgman 法).
+func clip(polygon []point, site point, other point) []point {
+	normal := point{other.X - site.X, other.Y - site.Y}
+	middle := point{(site.X + other.X) / 2, (site.Y + other.Y) / 2}
+	distance := func(p point) float64 {
+		return (p.X-middle.X)*normal.X + (p.Y-middle.Y)*normal.Y
+	}
+
+	var clipped []point
+	for i, current := range polygon {
+		previous := polygon[(i+len(polygon)-1)%len(polygon)]
+		dc, dp := distance(current), distance(previous)
+		if (dc <= 0) != (dp <= 0) {
+			t := dp / (dp - dc)
+			clipped = append(clipped, point{previous.X + t*(current.X-previous.X), previous.Y + t*(current.Y-previous.Y)})
+		}
+		if dc <= 0 {
+			clipped = append(clipped, current)
+		}
+	}
+	return clipped
+}
@@ -0,0 +1,86 @@
+// replay は RECORD_FILE で記録したリクエストを起動中の API に送り、ステータスコードとレスポンスの差異を報告します.
+//
+//	go run ./cmd/replay -base-url http://localhost:8080 recorded.jsonl
+//
+// レスポンスはデータベースの内容により変わるため、記録時と同じデータに対して実行してください.
+// -status-only を指定するとステータスコードのみ比較します.
+// 日時を含むレスポンスや、値を伏せて記録したクエリパラメタを含むリクエストは、ハッシュ値が記録されないためステータスコードのみ比較します.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/p2pquake/web-api-v2/recording"
+)
+
+func main() {
+	baseURL := flag.String("base-url", "http://localhost:8080", "base URL of the API (without /v2)")
+	statusOnly := flag.Bool("status-only", false, "compare status codes only")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: replay [flags] <recorded.jsonl>")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("record read error: %v", err)
+	}
+	entries, err := recording.Read(file)
+	file.Close()
+	if err != nil {
+		log.Fatalf("record parse error: %v", err)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	if run(os.Stdout, client, *baseURL, entries, *statusOnly) > 0 {
+		os.Exit(1)
+	}
+}
+
+// run は記録したリクエストを順に再生して結果を w に書き込み、差異のあったリクエストの件数を返す.
+func run(w io.Writer, client *http.Client, baseURL string, entries []recording.Entry, statusOnly bool) int {
+	differences := 0
+	for _, entry := range entries {
+		status, hash, err := recording.Replay(client, baseURL, entry)
+		if err != nil {
+			fmt.Fprintf(w, "ERR  %s: %v\n", describe(entry), err)
+			differences++
+			continue
+		}
+
+		if diffs := entry.Diff(status, hash, statusOnly); len(diffs) > 0 {
+			fmt.Fprintf(w, "DIFF %s: %s\n", describe(entry), strings.Join(diffs, ", "))
+			differences++
+			continue
+		}
+		fmt.Fprintf(w, "ok   %s\n", describe(entry))
+	}
+
+	if differences > 0 {
+		fmt.Fprintf(w, "%d of %d request(s) differ\n", differences, len(entries))
+	} else {
+		fmt.Fprintf(w, "all %d request(s) matched\n", len(entries))
+	}
+	return differences
+}
+
+func describe(entry recording.Entry) string {
+	s := entry.Method + " " + entry.Path
+	if entry.Query != "" {
+		s += "?" + entry.Query
+	}
+	if entry.Accept != "" {
+		s += " (Accept: " + entry.Accept + ")"
+	}
+	return s
+}
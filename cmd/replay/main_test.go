@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/recording"
+	"github.com/ugorji/go/codec"
+)
+
+// newServer は Accept ヘッダに応じて JSON または MessagePack でマップを返却するサーバを返す.
+func newServer(t *testing.T, recorder *recording.Recorder, count int) *httptest.Server {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	if recorder != nil {
+		r.Use(recorder.Middleware())
+	}
+	r.GET("/v2/items", func(c *gin.Context) {
+		data := map[string]int{"10": count, "20": count + 1, "30": count + 2, "40": count + 3, "45": count + 4}
+		if c.GetHeader("Accept") != "application/msgpack" {
+			c.JSON(200, data)
+			return
+		}
+		handle := &codec.MsgpackHandle{}
+		handle.Canonical = true
+		var body []byte
+		if err := codec.NewEncoderBytes(&body, handle).Encode(data); err != nil {
+			t.Fatal(err)
+		}
+		c.Data(200, "application/msgpack", body)
+	})
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recorded.jsonl")
+	recorder, err := recording.Open(path, func(method string, path string) []string { return []string{"limit"} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := newServer(t, recorder, 1)
+	for _, accept := range []string{"", "application/msgpack"} {
+		req, _ := http.NewRequest("GET", recorded.URL+"/v2/items?limit=10", nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+	}
+	recorder.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := recording.Read(file)
+	file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("recorded %d entries, want 2", len(entries))
+	}
+
+	t.Run("same responses", func(t *testing.T) {
+		var out bytes.Buffer
+		if differences := run(&out, http.DefaultClient, recorded.URL, entries, false); differences != 0 {
+			t.Errorf("run() = %d differences, want 0\n%s", differences, out.String())
+		}
+	})
+
+	t.Run("changed responses", func(t *testing.T) {
+		changed := newServer(t, nil, 2)
+		var out bytes.Buffer
+		if differences := run(&out, http.DefaultClient, changed.URL, entries, false); differences != 2 {
+			t.Errorf("run() = %d differences, want 2\n%s", differences, out.String())
+		}
+		if !strings.Contains(out.String(), "DIFF GET /v2/items?limit=10 (Accept: application/msgpack): body differs") {
+			t.Errorf("output does not report the msgpack difference\n%s", out.String())
+		}
+	})
+
+	t.Run("status only", func(t *testing.T) {
+		changed := newServer(t, nil, 2)
+		var out bytes.Buffer
+		if differences := run(&out, http.DefaultClient, changed.URL, entries, true); differences != 0 {
+			t.Errorf("run() = %d differences, want 0\n%s", differences, out.String())
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package main
+
+// contractCase は仕様書の servers (/v2) からの相対パスとクエリ、期待するステータスコード.
+type contractCase struct {
+	Path   string
+	Query  string
+	Status int
+}
+
+// skipCoverage は HTTP では検証できないため、テストケースがなくてもよいパス.
+var skipCoverage = map[string]bool{
+	"/ws": true,
+}
+
+// databaseFreePaths はデータベースを用いないパス. TEST_MONGODB_URL を指定しない場合も検証する.
+var databaseFreePaths = map[string]bool{
+	"/areas":          true,
+	"/areas.geojson":  true,
+	"/docs":           true,
+	"/openapi.json":   true,
+	"/openapi.yaml":   true,
+	"/p2pquake.proto": true,
+}
+
+var contractCases = []contractCase{
+	{"/history", "", 200},
+	{"/history", "codes=551&codes=552&limit=5", 200},
+	{"/history", "codes=554&codes=555&codes=561&codes=9611", 200},
+	{"/history", "offset=1&limit=100", 200},
+	{"/history", "limit=101", 400},
+	{"/history", "offset=-1", 400},
+	{"/history", "unknown=1", 400},
+	{"/history", "codes=abc", 400},
+	{"/history", "lang=en", 200},
+	{"/history", "lang=fr", 400},
+
+	{"/jma/quake", "", 200},
+	{"/jma/quake", "limit=100&offset=1&order=1", 200},
+	{"/jma/quake", "quake_type=DetailScale&min_scale=10&max_scale=70", 200},
+	{"/jma/quake", "min_magnitude=3.0&max_magnitude=7.0&since_date=20190101&until_date=20211231", 200},
+	{"/jma/quake", "prefectures%5B%5D=%E6%B2%96%E7%B8%84%E7%9C%8C%2C10", 200},
+	{"/jma/quake", "format=quakeml", 200},
+	{"/jma/quake", "format=kml", 200},
+	{"/jma/quake", "lang=en", 200},
+	{"/jma/quake", "lang=ja", 200},
+	{"/jma/quake", "limit=101", 400},
+	{"/jma/quake", "order=2", 400},
+	{"/jma/quake", "quake_type=Unknown", 400},
+	{"/jma/quake", "min_scale=35", 400},
+	{"/jma/quake", "since_date=2019", 400},
+	{"/jma/quake", "prefectures%5B%5D=%E6%B2%96%E7%B8%84%E7%9C%8C", 400},
+	{"/jma/quake", "format=csv", 400},
+	{"/jma/quake", "unknown=1", 400},
+	{"/jma/quake", "since_date=20211231&until_date=20190101", 400},
+	{"/jma/quake", "min_scale=70&max_scale=10", 400},
+	{"/jma/quake", "min_magnitude=7.0&max_magnitude=3.0", 400},
+	{"/jma/quake", "limit=abc", 400},
+	{"/jma/quake", "lang=fr", 400},
+
+	{"/jma/quake/5ee1681202add671a1e1ae39", "", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae38", "", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae39", "include=userquake_events", 200},
+	{"/jma/quake/60b0f5f102add676dd000005", "include=userquake_events", 200},
+	{"/jma/quake/60b0f5f102add676dd000005", "include=userquake_events&lang=en", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae39", "include=unknown", 400},
+	{"/jma/quake/invalid", "", 400},
+	{"/jma/quake/000000000000000000000000", "", 404},
+	{"/jma/quake/5ee1ad7e02add676dd5a67a0", "", 404},
+	{"/jma/quake/5ee1681202add671a1e1ae40/estimated_intensity", "", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae40/estimated_intensity", "fault_type=interplate&avs30=300&min_scale=40", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae40/estimated_intensity", "lang=en", 200},
+	{"/jma/quake/5ee1681202add671a1e1ae38/estimated_intensity", "", 404},
+	{"/jma/quake/000000000000000000000000/estimated_intensity", "", 404},
+	{"/jma/quake/invalid/estimated_intensity", "", 400},
+	{"/jma/quake/5ee1681202add671a1e1ae40/estimated_intensity", "fault_type=unknown", 400},
+	{"/jma/quake/5ee1681202add671a1e1ae40/estimated_intensity", "avs30=50", 400},
+
+	{"/jma/tsunami", "", 200},
+	{"/jma/tsunami", "limit=100&offset=0&order=-1&since_date=20190101&until_date=20191231", 200},
+	{"/jma/tsunami", "limit=0", 200},
+	{"/jma/tsunami", "lang=en", 200},
+	{"/jma/tsunami", "limit=101", 400},
+	{"/jma/tsunami", "until_date=abcdefgh", 400},
+	{"/jma/tsunami", "unknown=1", 400},
+	{"/jma/tsunami", "since_date=20191231&until_date=20190101", 400},
+
+	{"/jma/tsunami/5ee1ad7e02add676dd5a67a0", "", 200},
+	{"/jma/tsunami/5ee1ad7e02add676dd5a67a1", "", 200},
+	{"/jma/tsunami/5ee1ad7e02add676dd5a67a0", "lang=en", 200},
+	{"/jma/tsunami/5ee1ad7e02add676dd5a67a0", "unknown=1", 400},
+	{"/jma/tsunami/invalid", "", 400},
+	{"/jma/tsunami/000000000000000000000000", "", 404},
+
+	{"/userquake", "", 200},
+	{"/userquake", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&limit=100", 200},
+	{"/userquake", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&area=250", 200},
+	{"/userquake", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&region=%E9%96%A2%E6%9D%B1&prefecture=%E6%9D%B1%E4%BA%AC", 200},
+	{"/userquake", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&aggregate=minute", 200},
+	{"/userquake", "area=999", 400},
+	{"/userquake", "prefecture=%E5%AD%98%E5%9C%A8%E3%81%97%E3%81%AA%E3%81%84", 400},
+	{"/userquake", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&lang=en", 200},
+	{"/userquake", "aggregate=hour", 400},
+
+	{"/userquake/events", "", 200},
+	{"/userquake/events", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&limit=100", 200},
+	{"/userquake/events", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&offset=1", 200},
+	{"/userquake/events", "since_time=2021-05-28", 400},
+	{"/userquake/events", "since_time=2021-05-28T23%3A00%3A00%2B09%3A00&until_time=2021-05-28T21%3A00%3A00%2B09%3A00", 400},
+	{"/userquake/events", "since_time=2021-05-01T00%3A00%3A00%2B09%3A00&until_time=2021-05-28T00%3A00%3A00%2B09%3A00", 400},
+	{"/userquake/events", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&lang=en", 200},
+	{"/userquake/events", "limit=101", 400},
+
+	{"/userquake/lead_times", "", 200},
+	{"/userquake/lead_times", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00", 200},
+	{"/userquake/lead_times", "until_time=tomorrow", 400},
+
+	{"/userquake/evaluations", "", 200},
+	{"/userquake/evaluations", "min_confidence=0.9&min_level=1&area=250&all=true", 200},
+	{"/userquake/evaluations", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&limit=100&offset=0", 200},
+	{"/userquake/evaluations", "all=true&lang=en", 200},
+	{"/userquake/evaluations", "min_level=5", 400},
+	{"/userquake/evaluations", "min_confidence=1.5", 400},
+	{"/userquake/evaluations", "area=999", 400},
+	{"/userquake/evaluations", "all=maybe", 400},
+
+	{"/eew-detections", "", 200},
+	{"/eew-detections", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&limit=100", 200},
+	{"/eew-detections", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&type=Full&window=300", 200},
+	{"/eew-detections", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&lang=en", 200},
+	{"/eew-detections", "type=Unknown", 400},
+	{"/eew-detections", "window=0", 400},
+	{"/eew-detections", "window=3601", 400},
+
+	{"/stats/quakes", "", 200},
+	{"/stats/quakes", "interval=day&since_date=20190101&until_date=20211231", 200},
+	{"/stats/quakes", "interval=week&min_scale=10", 200},
+	{"/stats/quakes", "interval=year&magnitude_bin=0.5", 200},
+	{"/stats/quakes", "quake_type=DetailScale&prefectures%5B%5D=%E6%B2%96%E7%B8%84%E7%9C%8C%2C10", 200},
+	{"/stats/quakes", "lang=en", 200},
+	{"/stats/quakes", "interval=hour", 400},
+	{"/stats/quakes", "magnitude_bin=0", 400},
+	{"/stats/quakes", "min_scale=70&max_scale=10", 400},
+	{"/stats/quakes", "limit=10", 400},
+
+	{"/stats/prefectures", "", 200},
+	{"/stats/prefectures", "since_date=20190101&until_date=20211231&min_scale=10", 200},
+	{"/stats/prefectures", "prefecture=%E6%B2%96%E7%B8%84%E7%9C%8C", 200},
+	{"/stats/prefectures", "lang=en", 200},
+	{"/stats/prefectures", "min_scale=35", 400},
+	{"/stats/prefectures", "since_date=20211231&until_date=20190101", 400},
+
+	{"/stats/gutenberg_richter", "", 200},
+	{"/stats/gutenberg_richter", "min_latitude=20&max_latitude=46&min_longitude=122&max_longitude=154&max_depth=100", 200},
+	{"/stats/gutenberg_richter", "bin=0.2&mc=3.0&since_date=20190101", 200},
+	{"/stats/gutenberg_richter", "lang=en", 200},
+	{"/stats/gutenberg_richter", "min_latitude=91", 400},
+	{"/stats/gutenberg_richter", "min_latitude=40&max_latitude=30", 400},
+	{"/stats/gutenberg_richter", "bin=0", 400},
+
+	{"/stations", "", 200},
+	{"/stations", "prefecture=%E7%9F%B3%E5%B7%9D%E7%9C%8C&is_area=false&limit=100", 200},
+	{"/stations", "name=%E8%BC%AA%E5%B3%B6&offset=1", 200},
+	{"/stations", "lang=en", 200},
+	{"/stations", "is_area=yes", 400},
+	{"/stations/%E8%BC%AA%E5%B3%B6%E5%B8%82%E9%B3%B3%E8%87%B3%E7%94%BA/observations", "", 200},
+	{"/stations/%E8%BC%AA%E5%B3%B6%E5%B8%82%E9%B3%B3%E8%87%B3%E7%94%BA/observations", "prefecture=%E7%9F%B3%E5%B7%9D%E7%9C%8C&min_scale=30&since_date=20200101&limit=100", 200},
+	{"/stations/%E8%BC%AA%E5%B3%B6%E5%B8%82%E9%B3%B3%E8%87%B3%E7%94%BA/observations", "lang=en", 200},
+	{"/stations/%E8%BC%AA%E5%B3%B6%E5%B8%82%E9%B3%B3%E8%87%B3%E7%94%BA/observations", "min_scale=35", 400},
+	{"/stations/%E8%BC%AA%E5%B3%B6%E5%B8%82%E9%B3%B3%E8%87%B3%E7%94%BA/observations", "since_date=20240101&until_date=20200101", 400},
+	{"/stations", "limit=101", 400},
+
+	{"/areapeers", "", 200},
+	{"/areapeers", "since_time=2021-05-28T21%3A00%3A00%2B09%3A00&until_time=2021-05-28T23%3A00%3A00%2B09%3A00&limit=100", 200},
+	{"/areapeers", "since_time=yesterday", 400},
+	{"/areapeers/latest", "", 200},
+	{"/areapeers/latest", "lang=en", 200},
+	{"/areapeers/latest", "limit=1", 400},
+
+	{"/areas", "", 200},
+	{"/areas", "region=%E9%96%A2%E6%9D%B1", 200},
+	{"/areas", "region=%E9%96%A2%E6%9D%B1&prefecture=%E6%9D%B1%E4%BA%AC", 200},
+	{"/areas", "prefecture=%E5%AD%98%E5%9C%A8%E3%81%97%E3%81%AA%E3%81%84", 200},
+	{"/areas", "lang=en", 200},
+	{"/areas", "code=250", 400},
+	{"/areas.geojson", "", 200},
+	{"/areas.geojson", "prefecture=%E6%9D%B1%E4%BA%AC", 200},
+	{"/areas.geojson", "lang=en", 200},
+	{"/areas.geojson", "code=250", 400},
+
+	{"/p2pquake.proto", "", 200},
+	{"/openapi.yaml", "", 200},
+	{"/openapi.json", "", 200},
+	{"/docs", "", 200},
+}
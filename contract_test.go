@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type contractFixtures struct {
+	Jma     []json.RawMessage `json:"jma"`
+	History []json.RawMessage `json:"history"`
+}
+
+// TestContract は contractCases の各リクエストを API に送り、ステータスコードとレスポンスが specification.yaml の記載どおりか検証する.
+// 仕様書に記載のないプロパティやステータスコードが返却された場合は失敗とする.
+//
+// TEST_MONGODB_URL を指定した場合は、一時的なデータベースに testdata/fixtures.json を登録してすべてのケースを検証する.
+// 指定しない場合は、パラメタの誤り (400) とデータベースを用いないパスのみ検証する.
+func TestContract(t *testing.T) {
+	if err := loadOpenAPI("http://localhost"); err != nil {
+		t.Fatalf("openapi load error: %v", err)
+	}
+	withDatabase := setupContractDatabase(t)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(gin.Recovery())
+	setupRoutes(r)
+
+	covered := map[string]bool{}
+	for _, tc := range contractCases {
+		tc := tc
+		t.Run(tc.String(), func(t *testing.T) {
+			operation, _ := openAPIDocument.FindOperation("GET", tc.Path)
+			if operation == nil {
+				t.Fatal("path is not documented")
+			}
+			covered[operation.Method+" "+operation.PathTemplate] = true
+
+			if !withDatabase && tc.Status != 400 && !databaseFreePaths[operation.PathTemplate] {
+				t.Skip("TEST_MONGODB_URL is not set")
+			}
+
+			target := "/v2" + tc.Path
+			if tc.Query != "" {
+				target += "?" + tc.Query
+			}
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+
+			for _, err := range checkContract(tc, recorder) {
+				t.Error(err)
+			}
+		})
+	}
+
+	if !withDatabase {
+		return
+	}
+	for _, operation := range openAPIDocument.Operations() {
+		key := operation.Method + " " + operation.PathTemplate
+		if !covered[key] && !skipCoverage[operation.PathTemplate] {
+			t.Errorf("%s: no test case", key)
+		}
+	}
+}
+
+// checkContract はレスポンスのステータスコード、 Content-Type 、 JSON の本文が仕様書に適合するか検証する.
+func checkContract(tc contractCase, recorder *httptest.ResponseRecorder) []error {
+	var errs []error
+	if recorder.Code != tc.Status {
+		errs = append(errs, fmt.Errorf("status %d, want %d: %s", recorder.Code, tc.Status, recorder.Body.String()))
+	}
+
+	operation, _ := openAPIDocument.FindOperation("GET", tc.Path)
+	response, ok := operation.Response(recorder.Code)
+	if !ok {
+		return append(errs, fmt.Errorf("status %d is not documented (documented: %s)", recorder.Code, operation.StatusText()))
+	}
+	body := recorder.Body.Bytes()
+	if len(body) == 0 || len(response.Content) == 0 {
+		return errs
+	}
+
+	contentType := recorder.Header().Get("Content-Type")
+	schema, ok := response.Schema(contentType)
+	if !ok {
+		return append(errs, fmt.Errorf("content type %q is not documented for status %d", contentType, recorder.Code))
+	}
+	if !strings.Contains(contentType, "json") {
+		return errs
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return append(errs, fmt.Errorf("invalid JSON: %v", err))
+	}
+	for _, err := range openAPIDocument.Validate(schema, value, true) {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// setupContractDatabase は TEST_MONGODB_URL のサーバに一時的なデータベースを作り、フィクスチャを登録する.
+// データベースはテストの終了時に削除する.
+func setupContractDatabase(t *testing.T) bool {
+	mongoURL := os.Getenv("TEST_MONGODB_URL")
+	if mongoURL == "" {
+		return false
+	}
+
+	b, err := os.ReadFile("testdata/fixtures.json")
+	if err != nil {
+		t.Fatalf("fixtures read error: %v", err)
+	}
+	var fixtures contractFixtures
+	if err := json.Unmarshal(b, &fixtures); err != nil {
+		t.Fatalf("fixtures parse error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURL))
+	if err != nil {
+		t.Fatalf("mongo connect error: %v", err)
+	}
+	database := client.Database(fmt.Sprintf("contract_%d", time.Now().UnixNano()))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+		database.Drop(ctx)
+		client.Disconnect(ctx)
+	})
+
+	jmaCollection = database.Collection("jma")
+	historyCollection = database.Collection("history")
+	targets := []struct {
+		collection *mongo.Collection
+		documents  []json.RawMessage
+	}{
+		{jmaCollection, fixtures.Jma},
+		{historyCollection, fixtures.History},
+	}
+	for _, target := range targets {
+		for _, raw := range target.documents {
+			var document bson.M
+			if err := bson.UnmarshalExtJSON(raw, false, &document); err != nil {
+				t.Fatalf("fixture parse error: %v", err)
+			}
+			if _, err := target.collection.InsertOne(ctx, document); err != nil {
+				t.Fatalf("fixture insert error: %v", err)
+			}
+		}
+	}
+	return true
+}
+
+func (tc contractCase) String() string {
+	q := tc.Query
+	if unescaped, err := url.QueryUnescape(q); err == nil {
+		q = unescaped
+	}
+	if q == "" {
+		return "GET " + tc.Path
+	}
+	return "GET " + tc.Path + "?" + q
+}
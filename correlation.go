@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const quakeTimeLayout = "2006/01/02 15:04:05"
+
+var userquakeCorrelationOptions = userquake.DefaultCorrelationOptions
+
+type QuakeDetailParam struct {
+	Include string `form:"include"`
+	Lang    string `form:"lang"`
+}
+
+type UserquakeLeadTimeParam struct {
+	SinceTime string `form:"since_time"`
+	UntilTime string `form:"until_time"`
+}
+
+func (p *UserquakeLeadTimeParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseTimeRange(p.SinceTime, p.UntilTime)
+	return invalidParams
+}
+
+// timeRange は検索する期間を返す. 省略した場合は直近 24 時間とする.
+func (p *UserquakeLeadTimeParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+// findCorrelationQuakes は発生日時が期間内の地震情報を、発生日時ごとにまとめて返す.
+// 震度速報や震源・震度情報など、同じ地震について複数発表された情報の震度観測点の都道府県を合わせる.
+func findCorrelationQuakes(ctx context.Context, since time.Time, until time.Time) ([]userquake.Quake, error) {
+	filters := bson.D{
+		{"code", 551},
+		{"earthquake.time", bson.D{{"$gte", since.Format(quakeTimeLayout)}, {"$lte", until.Format(quakeTimeLayout)}}},
+	}
+	cur, err := jmaCollection.Find(ctx, filters, options.Find().SetSort(bson.D{{"issue.time", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var quakes []userquake.Quake
+	index := map[string]int{}
+	for cur.Next(ctx) {
+		var result bson.M
+		if err := cur.Decode(&result); err != nil {
+			return nil, err
+		}
+
+		earthquake := recordMap(result, "earthquake")
+		occurred := recordString(earthquake, "time")
+		t, err := parseJSTTime(occurred)
+		if err != nil {
+			continue
+		}
+		issueTime, err := parseJSTTime(recordString(recordMap(result, "issue"), "time"))
+		if err != nil {
+			continue
+		}
+
+		i, ok := index[occurred]
+		if !ok {
+			i = len(quakes)
+			index[occurred] = i
+			quakes = append(quakes, userquake.Quake{
+				ID:        recordID(result),
+				Time:      t,
+				IssueTime: issueTime,
+				Data: primitive.M{
+					"id":         recordID(result),
+					"time":       occurred,
+					"issue_time": recordString(recordMap(result, "issue"), "time"),
+					"max_scale":  -1,
+				},
+			})
+		}
+
+		quake := &quakes[i]
+		summary := quake.Data.(primitive.M)
+		if maxScale, ok := recordInt(earthquake, "maxScale"); ok && maxScale > summary["max_scale"].(int) {
+			summary["max_scale"] = maxScale
+		}
+		if hypocenter := recordMap(earthquake, "hypocenter"); hypocenter != nil {
+			if name := recordString(hypocenter, "name"); name != "" {
+				summary["hypocenter"] = name
+			}
+			if magnitude, ok := recordFloat(hypocenter, "magnitude"); ok && magnitude >= 0 {
+				summary["magnitude"] = magnitude
+			}
+		}
+		for _, point := range recordArray(result, "points") {
+			quake.Prefectures = append(quake.Prefectures, recordString(point, "pref"))
+		}
+	}
+	return quakes, cur.Err()
+}
+
+// quakeMatches は地震感知情報のまとまりに対応する地震情報を、発表までの時間 (秒) とあわせて返す.
+func quakeMatches(matches []userquake.Match) []primitive.M {
+	items := make([]primitive.M, 0, len(matches))
+	for _, match := range matches {
+		item := primitive.M{}
+		for key, value := range match.Quake.Data.(primitive.M) {
+			item[key] = value
+		}
+		item["lead_time"] = match.LeadTime.Seconds()
+		item["prefectures"] = match.Prefectures
+		items = append(items, item)
+	}
+	return items
+}
+
+// correlatedUserquakeEvents は地震情報に対応する地震感知情報のまとまりを返す.
+func correlatedUserquakeEvents(ctx context.Context, item bson.M) ([]primitive.M, error) {
+	events := []primitive.M{}
+	occurred := recordString(recordMap(item, "earthquake"), "time")
+	t, err := parseJSTTime(occurred)
+	if err != nil {
+		return events, nil
+	}
+
+	quakes, err := findCorrelationQuakes(ctx, t, t)
+	if err != nil {
+		return nil, err
+	}
+	if len(quakes) == 0 {
+		return events, nil
+	}
+
+	// まとまりの開始日時が照合の範囲に入るよう、前後に余裕を持たせて地震感知情報を取得する.
+	margin := userquakeClusterOptions.Gap + userquakeClusterOptions.MaxDuration
+	clusters, err := findUserquakeClusters(ctx, t.Add(-userquakeCorrelationOptions.After-margin), t.Add(userquakeCorrelationOptions.Before+margin))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range clusters {
+		matches := userquakeCorrelationOptions.Correlate(cluster, quakes)
+		if len(matches) == 0 {
+			continue
+		}
+		event := userquakeEvent(cluster)
+		event["lead_time"] = matches[0].LeadTime.Seconds()
+		event["prefectures"] = matches[0].Prefectures
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func getUserquakeLeadTimes(c *gin.Context) {
+	var leadTimeParam UserquakeLeadTimeParam
+	if !bindQuery(c, &leadTimeParam) {
+		return
+	}
+	since, until := leadTimeParam.timeRange()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	clusters, err := findUserquakeClusters(ctx, since, until)
+	if err != nil {
+		log.Printf("userquake cluster error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	quakes, err := findCorrelationQuakes(ctx, since.Add(-userquakeCorrelationOptions.Before), until.Add(userquakeCorrelationOptions.After))
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	var leadTimes []time.Duration
+	for _, cluster := range clusters {
+		if matches := userquakeCorrelationOptions.Correlate(cluster, quakes); len(matches) > 0 {
+			leadTimes = append(leadTimes, matches[0].LeadTime)
+		}
+	}
+	stats := userquake.LeadTimeStatistics(leadTimes)
+
+	result := primitive.M{
+		"since_time":     since.Format(time.RFC3339),
+		"until_time":     until.Format(time.RFC3339),
+		"events":         len(clusters),
+		"matched_events": len(leadTimes),
+		"match_rate":     0.0,
+		"lead_time":      primitive.M{"count": stats.Count},
+	}
+	if len(clusters) > 0 {
+		result["match_rate"] = math.Round(float64(len(leadTimes))/float64(len(clusters))*1000) / 1000
+	}
+	if stats.Count > 0 {
+		result["lead_time"] = primitive.M{
+			"count":  stats.Count,
+			"min":    stats.Min.Seconds(),
+			"max":    stats.Max.Seconds(),
+			"mean":   stats.Mean.Seconds(),
+			"median": stats.Median.Seconds(),
+			"p90":    stats.P90.Seconds(),
+		}
+	}
+
+	respond(c, 200, result, &pb.UserquakeLeadTimes{})
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// eewFollowWindow は緊急地震速報の発表検出に続く地震情報・地震感知情報を探す時間幅.
+var eewFollowWindow = 10 * time.Minute
+
+// eewOriginMargin は発表検出より前に発生した地震を対象とする時間幅. 地震情報の発生日時は分単位のため余裕を持たせる.
+const eewOriginMargin = 3 * time.Minute
+
+type EEWDetectionParam struct {
+	Offset    int64  `form:"offset"`
+	Limit     int64  `form:"limit"`
+	SinceTime string `form:"since_time"`
+	UntilTime string `form:"until_time"`
+	Type      string `form:"type"`
+	Window    int64  `form:"window"`
+	Lang      string `form:"lang"`
+}
+
+func (p *EEWDetectionParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseTimeRange(p.SinceTime, p.UntilTime)
+	return invalidParams
+}
+
+// timeRange は検索する期間を返す. 省略した場合は直近 24 時間とする.
+func (p *EEWDetectionParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+func searchEEWDetections(c *gin.Context) {
+	var detectionParam EEWDetectionParam
+	if !bindQuery(c, &detectionParam) {
+		return
+	}
+	since, until := detectionParam.timeRange()
+	window := eewFollowWindow
+	if detectionParam.Window != 0 {
+		window = time.Duration(detectionParam.Window) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	limit := detectionParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	offset := detectionParam.Offset
+	findOptions := options.FindOptions{Limit: &limit, Skip: &offset, Sort: bson.D{{"time", -1}}}
+
+	filters := bson.D{
+		{"code", 554},
+		{"time", bson.D{{"$gte", since.Format(recordTimeLayout)}, {"$lte", until.Format(recordTimeLayout)}}},
+	}
+	if detectionParam.Type != "" {
+		filters = append(filters, bson.E{"type", detectionParam.Type})
+	}
+
+	cur, err := historyCollection.Find(ctx, filters, &findOptions)
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	detections := make([]bson.M, 0)
+	if err := cur.All(ctx, &detections); err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	items, err := followEEWDetections(ctx, detections, window)
+	if err != nil {
+		log.Printf("eew detection follow error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	if detectionParam.Lang == langEnglish {
+		for _, item := range items {
+			localizeEEWDetection(item)
+		}
+	}
+
+	respond(c, 200, items, &pb.EEWDetectionSummaries{})
+}
+
+// followEEWDetections は発表検出ごとに、 window 以内に発表された地震情報と、始まった地震感知情報のまとまりを付加する.
+func followEEWDetections(ctx context.Context, detections []bson.M, window time.Duration) ([]primitive.M, error) {
+	items := make([]primitive.M, 0, len(detections))
+	if len(detections) == 0 {
+		return items, nil
+	}
+
+	times := make([]time.Time, len(detections))
+	first, last := time.Time{}, time.Time{}
+	for i, detection := range detections {
+		t, err := parseJSTTime(recordString(detection, "time"))
+		if err != nil {
+			return nil, err
+		}
+		times[i] = t
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if last.IsZero() || t.After(last) {
+			last = t
+		}
+	}
+
+	quakes, err := findFollowingQuakes(ctx, first, last.Add(window))
+	if err != nil {
+		return nil, err
+	}
+	margin := userquakeClusterOptions.Gap + userquakeClusterOptions.MaxDuration
+	clusters, err := findUserquakeClusters(ctx, first.Add(-time.Minute-margin), last.Add(window+margin))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, detection := range detections {
+		t := times[i]
+
+		following := []primitive.M{}
+		for _, quake := range quakes {
+			issueTime := quake["issue_time"].(time.Time)
+			originTime := quake["origin_time"].(time.Time)
+			if issueTime.Before(t) || issueTime.After(t.Add(window)) || originTime.Before(t.Add(-eewOriginMargin)) {
+				continue
+			}
+			item := primitive.M{}
+			for key, value := range quake {
+				if key != "issue_time" && key != "origin_time" {
+					item[key] = value
+				}
+			}
+			item["delay"] = issueTime.Sub(t).Seconds()
+			following = append(following, item)
+		}
+
+		item := primitive.M{
+			"id":        recordID(detection),
+			"code":      554,
+			"time":      recordString(detection, "time"),
+			"type":      recordString(detection, "type"),
+			"confirmed": len(following) > 0,
+			"quakes":    following,
+		}
+		if cluster, ok := nearestCluster(clusters, t, window); ok {
+			event := userquakeEvent(cluster)
+			event["delay"] = cluster.Start.Sub(t).Seconds()
+			item["userquake_event"] = event
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// findFollowingQuakes は発表日時が期間内の地震情報を、発表日時の昇順に返す.
+func findFollowingQuakes(ctx context.Context, since time.Time, until time.Time) ([]primitive.M, error) {
+	filters := bson.D{
+		{"code", 551},
+		{"issue.time", bson.D{{"$gte", since.Format(quakeTimeLayout)}, {"$lte", until.Format(quakeTimeLayout)}}},
+	}
+	cur, err := jmaCollection.Find(ctx, filters, options.Find().SetSort(bson.D{{"issue.time", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var quakes []primitive.M
+	for cur.Next(ctx) {
+		var result bson.M
+		if err := cur.Decode(&result); err != nil {
+			return nil, err
+		}
+
+		issue := recordMap(result, "issue")
+		earthquake := recordMap(result, "earthquake")
+		issueTime, err := parseJSTTime(recordString(issue, "time"))
+		if err != nil {
+			continue
+		}
+		originTime, err := parseJSTTime(recordString(earthquake, "time"))
+		if err != nil {
+			continue
+		}
+
+		quake := primitive.M{
+			"id":          recordID(result),
+			"type":        recordString(issue, "type"),
+			"time":        recordString(earthquake, "time"),
+			"issue_time":  issueTime,
+			"origin_time": originTime,
+			"max_scale":   -1,
+		}
+		if maxScale, ok := recordInt(earthquake, "maxScale"); ok {
+			quake["max_scale"] = maxScale
+		}
+		if hypocenter := recordMap(earthquake, "hypocenter"); hypocenter != nil {
+			if name := recordString(hypocenter, "name"); name != "" {
+				quake["hypocenter"] = name
+			}
+			if magnitude, ok := recordFloat(hypocenter, "magnitude"); ok && magnitude >= 0 {
+				quake["magnitude"] = magnitude
+			}
+		}
+		quakes = append(quakes, quake)
+	}
+	return quakes, cur.Err()
+}
+
+// nearestCluster は t の 1 分前から window 後までに始まったまとまりのうち、開始が t に最も近いものを返す.
+func nearestCluster(clusters []userquake.Cluster, t time.Time, window time.Duration) (userquake.Cluster, bool) {
+	var nearest userquake.Cluster
+	found := false
+	for _, cluster := range clusters {
+		if cluster.Start.Before(t.Add(-time.Minute)) || cluster.Start.After(t.Add(window)) {
+			continue
+		}
+		if !found || userquake.AbsDuration(cluster.Start.Sub(t)) < userquake.AbsDuration(nearest.Start.Sub(t)) {
+			nearest = cluster
+			found = true
+		}
+	}
+	return nearest, found
+}
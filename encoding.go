@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/ugorji/go/codec"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	mimeJSON     = "application/json"
+	mimeMsgPack  = "application/msgpack"
+	mimeProtobuf = "application/x-protobuf"
+)
+
+var acceptAliases = map[string]string{
+	"application/json":       mimeJSON,
+	"application/msgpack":    mimeMsgPack,
+	"application/x-msgpack":  mimeMsgPack,
+	"application/x-protobuf": mimeProtobuf,
+	"application/protobuf":   mimeProtobuf,
+	"*/*":                    mimeJSON,
+	"application/*":          mimeJSON,
+}
+
+// msgpackHandle は MessagePack のエンコード設定. 同じ値が同じバイト列になるよう、マップのキーを並べ替える.
+var msgpackHandle = func() *codec.MsgpackHandle {
+	handle := &codec.MsgpackHandle{WriteExt: true}
+	handle.Canonical = true
+	return handle
+}()
+
+var historyRecordFields = map[int64]string{
+	551:  "jma_quake",
+	552:  "jma_tsunami",
+	554:  "eew_detection",
+	555:  "areapeers",
+	561:  "userquake",
+	9611: "userquake_evaluation",
+}
+
+// respond は Accept ヘッダに応じて JSON 、 MessagePack 、 Protocol Buffers のいずれかでレスポンスを返却する.
+// message は Protocol Buffers で返却する際のメッセージで、 nil の場合は google.protobuf.Value として返却する.
+// MessagePack と Protocol Buffers はマップのキーを並べ替え、同じデータは同じバイト列で返却する.
+func respond(c *gin.Context, code int, data interface{}, message proto.Message) {
+	c.Header("Vary", "Accept")
+
+	switch negotiateEncoding(c.GetHeader("Accept")) {
+	case mimeMsgPack:
+		value, err := normalizeValue(data)
+		if err != nil {
+			log.Printf("normalize error: %v\n", err)
+			respondProblem(c, 500, "response encoding error")
+			return
+		}
+
+		var body []byte
+		if err := codec.NewEncoderBytes(&body, msgpackHandle).Encode(value); err != nil {
+			log.Printf("msgpack encode error: %v\n", err)
+			respondProblem(c, 500, "response encoding error")
+			return
+		}
+		c.Data(code, mimeMsgPack, body)
+	case mimeProtobuf:
+		value, err := normalizeValue(data)
+		if err != nil {
+			log.Printf("normalize error: %v\n", err)
+			respondProblem(c, 500, "response encoding error")
+			return
+		}
+
+		message, err := toProtoMessage(value, message)
+		if err != nil {
+			log.Printf("protobuf convert error: %v\n", err)
+			respondProblem(c, 500, "response encoding error")
+			return
+		}
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			log.Printf("protobuf encode error: %v\n", err)
+			respondProblem(c, 500, "response encoding error")
+			return
+		}
+		c.Data(code, mimeProtobuf+"; messageType="+string(message.ProtoReflect().Descriptor().FullName()), body)
+	default:
+		c.JSON(code, data)
+	}
+}
+
+// negotiateEncoding は Accept ヘッダのうち最も優先度の高い対応形式を返す. 該当がなければ JSON とする.
+func negotiateEncoding(accept string) string {
+	type candidate struct {
+		mimeType string
+		quality  float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		mimeType, ok := acceptAliases[mediaType]
+		if !ok {
+			continue
+		}
+		quality := 1.0
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil {
+			quality = q
+		}
+		if quality > 0 {
+			candidates = append(candidates, candidate{mimeType, quality})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return mimeJSON
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+	return candidates[0].mimeType
+}
+
+// normalizeValue は JSON で返却する場合と同じ構造になるよう、値を JSON 互換の型に変換する.
+func normalizeValue(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return convertNumbers(value), nil
+}
+
+func convertNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = convertNumbers(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = convertNumbers(child)
+		}
+	}
+	return value
+}
+
+func toProtoMessage(value interface{}, message proto.Message) (proto.Message, error) {
+	if message == nil {
+		return structpb.NewValue(value)
+	}
+
+	if items, ok := value.([]interface{}); ok {
+		if _, ok := message.(*pb.History); ok {
+			for i, item := range items {
+				items[i] = toHistoryRecord(item)
+			}
+		}
+		value = map[string]interface{}{"items": items}
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+func toHistoryRecord(item interface{}) interface{} {
+	record, ok := item.(map[string]interface{})
+	if !ok {
+		return item
+	}
+	code, _ := record["code"].(int64)
+	field, ok := historyRecordFields[code]
+	if !ok {
+		field = "other"
+	}
+	return map[string]interface{}{field: record}
+}
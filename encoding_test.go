@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestRespondDeterministic は同じマップを 2 回返却した場合に、同じバイト列になることを検証する.
+func TestRespondDeterministic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newData := func() primitive.M {
+		scales := primitive.M{}
+		for _, scale := range []string{"-1", "10", "20", "30", "40", "45", "50", "55", "60", "70"} {
+			scales[scale] = len(scale)
+		}
+		return primitive.M{"period": "2021/05/01", "count": 10, "scales": scales}
+	}
+
+	tests := []struct {
+		accept  string
+		message func() proto.Message
+	}{
+		{"application/msgpack", func() proto.Message { return nil }},
+		{"application/x-protobuf", func() proto.Message { return &pb.QuakeStats{} }},
+		{"application/x-protobuf", func() proto.Message { return nil }},
+	}
+
+	for _, tt := range tests {
+		var first []byte
+		for i := 0; i < 20; i++ {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest("GET", "/", nil)
+			c.Request.Header.Set("Accept", tt.accept)
+			respond(c, 200, newData(), tt.message())
+
+			if w.Code != 200 {
+				t.Fatalf("%s: status = %d, body = %s", tt.accept, w.Code, w.Body.String())
+			}
+			if i == 0 {
+				first = w.Body.Bytes()
+				continue
+			}
+			if !bytes.Equal(w.Body.Bytes(), first) {
+				t.Fatalf("%s (message %T): body differs between identical responses", tt.accept, tt.message())
+			}
+		}
+	}
+}
+
+// TestRespondProtobufEnglishLabels は lang=en で付加した英語表記が Protocol Buffers のレスポンスに含まれることを検証する.
+func TestRespondProtobufEnglishLabels(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	items := []primitive.M{
+		{
+			"id": "quake", "code": 551, "time": "2024/01/01 16:10:05.000",
+			"issue":      primitive.M{"source": "気象庁", "time": "2024/01/01 16:10:00", "type": "DetailScale"},
+			"earthquake": primitive.M{"time": "2024/01/01 16:10:00", "maxScale": 70, "domesticTsunami": "Warning", "foreignTsunami": "Unknown"},
+			"points":     primitive.A{primitive.M{"pref": "石川県", "addr": "志賀町香能", "isArea": false, "scale": 70}},
+		},
+		{
+			"id": "tsunami", "code": 552, "time": "2024/01/01 16:22:00.000",
+			"issue": primitive.M{"source": "気象庁", "time": "2024/01/01 16:22:00", "type": "Focus"},
+			"areas": primitive.A{primitive.M{"grade": "MajorWarning", "immediate": true, "name": "能登"}},
+		},
+		{"id": "userquake", "code": 561, "time": "2024/01/01 16:10:10.000", "area": 250},
+		{
+			"id": "evaluation", "code": 9611, "time": "2024/01/01 16:10:20.000", "count": 3, "confidence": 0.97,
+			"area_confidences": primitive.M{"250": primitive.M{"confidence": 0.5, "count": 3, "display": "C"}},
+		},
+	}
+	for _, item := range items {
+		localizeRecord(item)
+	}
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("GET", "/v2/history?lang=en", nil)
+	c.Request.Header.Set("Accept", "application/x-protobuf")
+	respond(c, 200, items, &pb.History{})
+
+	var history pb.History
+	if err := proto.Unmarshal(w.Body.Bytes(), &history); err != nil {
+		t.Fatalf("protobuf decode error: %v", err)
+	}
+	if len(history.Items) != 4 {
+		t.Fatalf("len(items) = %d, want 4", len(history.Items))
+	}
+
+	tokyo, _ := userquake.EnglishName(250)
+	quake := history.Items[0].GetJmaQuake()
+	tsunami := history.Items[1].GetJmaTsunami()
+	tests := []struct {
+		field string
+		got   string
+		want  string
+	}{
+		{"issue.type_en", quake.GetIssue().GetTypeEn(), englishIssueTypes["DetailScale"]},
+		{"earthquake.max_scale_en", quake.GetEarthquake().GetMaxScaleEn(), englishScaleNames[70]},
+		{"earthquake.domestic_tsunami_en", quake.GetEarthquake().GetDomesticTsunamiEn(), englishDomesticTsunami["Warning"]},
+		{"earthquake.foreign_tsunami_en", quake.GetEarthquake().GetForeignTsunamiEn(), englishForeignTsunami["Unknown"]},
+		{"points[0].pref_en", quake.GetPoints()[0].GetPrefEn(), "Ishikawa"},
+		{"points[0].scale_en", quake.GetPoints()[0].GetScaleEn(), englishScaleNames[70]},
+		{"tsunami issue.type_en", tsunami.GetIssue().GetTypeEn(), englishIssueTypes["Focus"]},
+		{"tsunami areas[0].grade_en", tsunami.GetAreas()[0].GetGradeEn(), englishTsunamiGrades["MajorWarning"]},
+		{"userquake area_en", history.Items[2].GetUserquake().GetAreaEn(), tokyo},
+		{"area_confidences.name_en", history.Items[3].GetUserquakeEvaluation().GetAreaConfidences()["250"].GetNameEn(), tokyo},
+	}
+	for _, tt := range tests {
+		if tt.want == "" {
+			t.Errorf("%s: no English label to compare", tt.field)
+			continue
+		}
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.field, tt.got, tt.want)
+		}
+	}
+}
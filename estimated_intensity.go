@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/seismicity"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// estimatedIntensityModel は推計に用いる距離減衰式と計測震度の式の名称.
+const estimatedIntensityModel = "Si and Midorikawa (1999) / Midorikawa et al. (1999)"
+
+type EstimatedIntensityParam struct {
+	FaultType string  `form:"fault_type"`
+	AVS30     float64 `form:"avs30"`
+	MinScale  int64   `form:"min_scale"`
+	Lang      string  `form:"lang"`
+}
+
+// estimatedArea は地域の代表点で推計した震度.
+type estimatedArea struct {
+	Code       int     `json:"code"`
+	Region     string  `json:"region"`
+	Prefecture string  `json:"prefecture"`
+	Name       string  `json:"name"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	Distance   float64 `json:"distance"`
+	PGV        float64 `json:"pgv"`
+	Intensity  float64 `json:"intensity"`
+	Scale      int     `json:"scale"`
+
+	RegionEn     string `json:"region_en,omitempty"`
+	PrefectureEn string `json:"prefecture_en,omitempty"`
+	NameEn       string `json:"name_en,omitempty"`
+	ScaleEn      string `json:"scale_en,omitempty"`
+}
+
+func getQuakeEstimatedIntensity(c *gin.Context) {
+	var estimateParam EstimatedIntensityParam
+	if !bindQuery(c, &estimateParam) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	result, ok := findJmaItem(ctx, c, 551)
+	if !ok {
+		return
+	}
+
+	earthquake := recordMap(result, "earthquake")
+	hypocenter := recordMap(earthquake, "hypocenter")
+	latitude, latitudeOK := recordFloat(hypocenter, "latitude")
+	longitude, longitudeOK := recordFloat(hypocenter, "longitude")
+	depth, depthOK := recordFloat(hypocenter, "depth")
+	magnitude, magnitudeOK := recordFloat(hypocenter, "magnitude")
+	if !latitudeOK || !longitudeOK || !depthOK || !magnitudeOK || latitude <= -200 || longitude <= -200 || depth < 0 || magnitude < 0 {
+		respondProblem(c, 404, "hypocenter or magnitude not available")
+		return
+	}
+
+	faultType := estimateParam.FaultType
+	if faultType == "" {
+		faultType = seismicity.Crustal
+	}
+	avs30 := estimateParam.AVS30
+	if avs30 == 0 {
+		avs30 = 400
+	}
+	minScale := int(estimateParam.MinScale)
+	if minScale == 0 {
+		minScale = 10
+	}
+
+	// 距離減衰式はモーメントマグニチュードの式だが、気象庁マグニチュードをそのまま用いる.
+	amplification := seismicity.SurfaceAmplification(avs30)
+	areas := []estimatedArea{}
+	maxScale := 0
+	userquake.Areas.Each(func(area userquake.Area) bool {
+		centroid, ok := userquake.Centroid(area.Code)
+		if !ok {
+			return true
+		}
+
+		distance := seismicity.HypocentralDistance(latitude, longitude, depth, centroid.Latitude, centroid.Longitude)
+		pgv := seismicity.BedrockVelocity(magnitude, depth, distance, faultType) * amplification
+		intensity := seismicity.InstrumentalIntensity(pgv)
+		scale := seismicity.Scale(intensity)
+		if scale > maxScale {
+			maxScale = scale
+		}
+		if scale < minScale {
+			return true
+		}
+
+		estimated := estimatedArea{
+			Code:       area.Code,
+			Region:     area.Region,
+			Prefecture: area.Prefecture,
+			Name:       area.Name,
+			Latitude:   centroid.Latitude,
+			Longitude:  centroid.Longitude,
+			Distance:   math.Round(distance*10) / 10,
+			PGV:        math.Round(pgv*100) / 100,
+			Intensity:  intensity,
+			Scale:      scale,
+		}
+		if estimateParam.Lang == langEnglish {
+			estimated.RegionEn, _ = userquake.EnglishRegion(area.Region)
+			estimated.PrefectureEn, _ = userquake.EnglishPrefecture(area.Prefecture)
+			estimated.NameEn, _ = userquake.EnglishName(area.Code)
+			estimated.ScaleEn = englishScaleNames[scale]
+		}
+		areas = append(areas, estimated)
+		return true
+	})
+	sort.SliceStable(areas, func(i, j int) bool {
+		if areas[i].Intensity != areas[j].Intensity {
+			return areas[i].Intensity > areas[j].Intensity
+		}
+		return areas[i].Code < areas[j].Code
+	})
+
+	estimate := primitive.M{
+		"id":   recordID(result),
+		"type": recordString(recordMap(result, "issue"), "type"),
+		"time": recordString(earthquake, "time"),
+		"hypocenter": primitive.M{
+			"name":      recordString(hypocenter, "name"),
+			"latitude":  latitude,
+			"longitude": longitude,
+			"depth":     depth,
+			"magnitude": magnitude,
+		},
+		"model": primitive.M{
+			"estimate":      true,
+			"name":          estimatedIntensityModel,
+			"fault_type":    faultType,
+			"avs30":         avs30,
+			"amplification": math.Round(amplification*1000) / 1000,
+		},
+		"max_scale": maxScale,
+		"areas":     areas,
+	}
+	if estimateParam.Lang == langEnglish {
+		setSnakeEnglishLabel(estimate, "type", englishIssueTypes)
+		setSnakeEnglishScale(estimate, "max_scale")
+	}
+
+	respond(c, 200, estimate, &pb.EstimatedIntensity{})
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/p2pquake/web-api-v2/recording"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const fdsnServiceVersion = "1.2.0"
+
+type FDSNEventParam struct {
+	StartTime            string   `form:"starttime"`
+	EndTime              string   `form:"endtime"`
+	MinLatitude          *float64 `form:"minlatitude" binding:"omitempty,min=-90,max=90"`
+	MaxLatitude          *float64 `form:"maxlatitude" binding:"omitempty,min=-90,max=90"`
+	MinLongitude         *float64 `form:"minlongitude" binding:"omitempty,min=-180,max=180"`
+	MaxLongitude         *float64 `form:"maxlongitude" binding:"omitempty,min=-180,max=180"`
+	MinDepth             *float64 `form:"mindepth"`
+	MaxDepth             *float64 `form:"maxdepth"`
+	MinMagnitude         float64  `form:"minmagnitude" binding:"min=0.0"`
+	MaxMagnitude         float64  `form:"maxmagnitude" binding:"min=0.0"`
+	MagnitudeType        string   `form:"magnitudetype"`
+	EventType            string   `form:"eventtype"`
+	EventID              string   `form:"eventid"`
+	IncludeAllOrigins    bool     `form:"includeallorigins"`
+	IncludeAllMagnitudes bool     `form:"includeallmagnitudes"`
+	IncludeArrivals      bool     `form:"includearrivals"`
+	Catalog              string   `form:"catalog"`
+	Contributor          string   `form:"contributor"`
+	Limit                int64    `form:"limit" binding:"min=0,max=1000"`
+	Offset               int64    `form:"offset" binding:"min=0"`
+	OrderBy              string   `form:"orderby" binding:"omitempty,oneof=time time-asc magnitude magnitude-asc"`
+	Format               string   `form:"format" binding:"omitempty,oneof=xml text"`
+	NoData               int      `form:"nodata" binding:"omitempty,oneof=204 404"`
+}
+
+var fdsnParamAliases = map[string]string{
+	"start":   "starttime",
+	"end":     "endtime",
+	"minlat":  "minlatitude",
+	"maxlat":  "maxlatitude",
+	"minlon":  "minlongitude",
+	"maxlon":  "maxlongitude",
+	"minmag":  "minmagnitude",
+	"maxmag":  "maxmagnitude",
+	"magtype": "magnitudetype",
+}
+
+var fdsnUnsupportedParams = []string{"latitude", "lat", "longitude", "lon", "minradius", "maxradius", "updatedafter"}
+
+// errFDSNNoData は条件に該当する地震が存在しないことが検索せずに分かる場合のエラー.
+var errFDSNNoData = errors.New("no data")
+
+func queryFDSNEvents(c *gin.Context) {
+	query := c.Request.URL.Query()
+	if err := normalizeFDSNQuery(query); err != nil {
+		fdsnError(c, 400, err.Error())
+		return
+	}
+	c.Request.URL.RawQuery = query.Encode()
+
+	var fdsnParam FDSNEventParam
+	if extraKeys := validateQueryParams(c, &fdsnParam); len(extraKeys) > 0 {
+		fdsnError(c, 400, "unknown parameters: "+strings.Join(extraKeys, ", "))
+		return
+	}
+	if err := c.ShouldBindWith(&fdsnParam, binding.Query); err != nil {
+		fdsnError(c, 400, err.Error())
+		return
+	}
+
+	nodata := fdsnParam.NoData
+	if nodata == 0 {
+		nodata = 204
+	}
+
+	pipeline, err := fdsnEventPipeline(fdsnParam)
+	if err == errFDSNNoData {
+		c.Status(nodata)
+		return
+	}
+	if err != nil {
+		fdsnError(c, 400, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cur, err := jmaCollection.Aggregate(ctx, pipeline, aggregateOptions())
+	if err != nil {
+		fdsnError(c, 500, "internal server error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	items := make([]bson.M, 0)
+	if err := cur.All(ctx, &items); err != nil {
+		fdsnError(c, 500, "internal server error")
+		return
+	}
+
+	if len(items) == 0 {
+		c.Status(nodata)
+		return
+	}
+
+	for _, item := range items {
+		cleanJmaRecord(item)
+	}
+
+	if fdsnParam.Format == "text" {
+		renderFDSNText(c, items)
+		return
+	}
+	if !fdsnParam.IncludeArrivals {
+		for _, item := range items {
+			delete(item, "points")
+		}
+	}
+	renderQuakeML(c, items)
+}
+
+// normalizeFDSNQuery は省略形のパラメタ名 (minmag など) を正式な名前に置き換える. 対応していないパラメタはエラーとする.
+func normalizeFDSNQuery(query url.Values) error {
+	for _, param := range fdsnUnsupportedParams {
+		if query.Has(param) {
+			return fmt.Errorf("parameter %q is not supported", param)
+		}
+	}
+	for alias, name := range fdsnParamAliases {
+		if values, ok := query[alias]; ok {
+			query[name] = append(query[name], values...)
+			delete(query, alias)
+		}
+	}
+	return nil
+}
+
+// fdsnEventPipeline は FDSN のパラメタから地震情報を検索する集計パイプラインを返す.
+// 発生日時はまとめる前に、震源の条件はまとめた後の最新の情報に対して絞り込む.
+// 震源やマグニチュードは続報で変わるため、まとめる前に絞り込むと、条件を満たさない最新の情報を返却することがある.
+func fdsnEventPipeline(fdsnParam FDSNEventParam) ([]bson.D, error) {
+	// P2P地震情報では気象庁のマグニチュード (Mj) のみ扱う.
+	if fdsnParam.MagnitudeType != "" && !strings.EqualFold(fdsnParam.MagnitudeType, "mj") {
+		return nil, errFDSNNoData
+	}
+	if fdsnParam.EventType != "" && !strings.Contains(fdsnParam.EventType, "earthquake") {
+		return nil, errFDSNNoData
+	}
+	if (fdsnParam.Catalog != "" && fdsnParam.Catalog != "JMA") || (fdsnParam.Contributor != "" && fdsnParam.Contributor != "JMA") {
+		return nil, errFDSNNoData
+	}
+
+	filters := bson.D{{"code", 551}}
+	if fdsnParam.StartTime != "" {
+		t, err := parseFDSNTime(fdsnParam.StartTime)
+		if err != nil {
+			return nil, errors.New("invalid starttime: " + fdsnParam.StartTime)
+		}
+		filters = append(filters, bson.E{"earthquake.time", bson.D{{"$gte", t.In(jst).Format("2006/01/02 15:04:05")}}})
+	}
+	if fdsnParam.EndTime != "" {
+		t, err := parseFDSNTime(fdsnParam.EndTime)
+		if err != nil {
+			return nil, errors.New("invalid endtime: " + fdsnParam.EndTime)
+		}
+		filters = append(filters, bson.E{"earthquake.time", bson.D{{"$lte", t.In(jst).Format("2006/01/02 15:04:05")}}})
+	}
+
+	eventFilters := quakeFilters(QuakeParam{MinMagnitude: fdsnParam.MinMagnitude, MaxMagnitude: fdsnParam.MaxMagnitude})
+	// 震源情報が存在しない情報は除く.
+	eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.latitude", bson.D{{"$gt", -200}}})
+	if fdsnParam.MinLatitude != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.latitude", bson.D{{"$gte", *fdsnParam.MinLatitude}}})
+	}
+	if fdsnParam.MaxLatitude != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.latitude", bson.D{{"$lte", *fdsnParam.MaxLatitude}}})
+	}
+	if fdsnParam.MinLongitude != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.longitude", bson.D{{"$gte", *fdsnParam.MinLongitude}}})
+	}
+	if fdsnParam.MaxLongitude != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.longitude", bson.D{{"$lte", *fdsnParam.MaxLongitude}}})
+	}
+	if fdsnParam.MinDepth != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$gte", *fdsnParam.MinDepth}}})
+	}
+	if fdsnParam.MaxDepth != nil {
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$lte", *fdsnParam.MaxDepth}}})
+		eventFilters = append(eventFilters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$gte", 0}}})
+	}
+	if fdsnParam.EventID != "" {
+		id, err := primitive.ObjectIDFromHex(fdsnParam.EventID)
+		if err != nil {
+			return nil, errFDSNNoData
+		}
+		eventFilters = append(eventFilters, bson.E{"_id", id})
+	}
+
+	// options
+	offset := fdsnParam.Offset
+	if offset > 0 {
+		// FDSN の offset は 1 始まり.
+		offset -= 1
+	}
+	limit := fdsnParam.Limit
+	if limit == 0 {
+		limit = 1000
+	}
+	sort := bson.D{{"earthquake.time", -1}}
+	switch fdsnParam.OrderBy {
+	case "time-asc":
+		sort = bson.D{{"earthquake.time", 1}}
+	case "magnitude":
+		sort = bson.D{{"earthquake.hypocenter.magnitude", -1}, {"earthquake.time", -1}}
+	case "magnitude-asc":
+		sort = bson.D{{"earthquake.hypocenter.magnitude", 1}, {"earthquake.time", 1}}
+	}
+
+	// 1 つの地震に対して複数の地震情報が発表されるため、発生日時ごとに最新の情報のみを返却する.
+	// 各地の震度は QuakeML の振幅 (amplitude) にのみ用いるため、 includearrivals を指定しない場合は含めない.
+	fields := []string{"issue", "earthquake"}
+	if fdsnParam.Format != "text" && fdsnParam.IncludeArrivals {
+		fields = append(fields, "points")
+	}
+	return append(quakeEventStages(filters, fields...),
+		bson.D{{"$match", eventFilters}},
+		bson.D{{"$sort", sort}},
+		bson.D{{"$skip", offset}},
+		bson.D{{"$limit", limit}},
+	), nil
+}
+
+func getFDSNVersion(c *gin.Context) {
+	c.String(200, fdsnServiceVersion)
+}
+
+func getFDSNCatalogs(c *gin.Context) {
+	c.Data(200, "application/xml; charset=utf-8", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Catalogs><Catalog>JMA</Catalog></Catalogs>
+`))
+}
+
+func getFDSNContributors(c *gin.Context) {
+	c.Data(200, "application/xml; charset=utf-8", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Contributors><Contributor>JMA</Contributor></Contributors>
+`))
+}
+
+func renderFDSNText(c *gin.Context, items []bson.M) {
+	var b strings.Builder
+	b.WriteString("#EventID|Time|Latitude|Longitude|Depth/km|Author|Catalog|Contributor|ContributorID|MagType|Magnitude|MagAuthor|EventLocationName\n")
+
+	for _, item := range items {
+		earthquake := recordMap(item, "earthquake")
+		hypocenter := recordMap(item, "earthquake.hypocenter")
+		if earthquake == nil || hypocenter == nil {
+			continue
+		}
+
+		originTime := ""
+		if t, err := parseJSTTime(recordString(earthquake, "time")); err == nil {
+			originTime = t.UTC().Format("2006-01-02T15:04:05")
+		}
+		latitude, _ := recordFloat(hypocenter, "latitude")
+		longitude, _ := recordFloat(hypocenter, "longitude")
+		depth := ""
+		if d, ok := recordFloat(hypocenter, "depth"); ok && d >= 0 {
+			depth = fmt.Sprint(d)
+		}
+		magType, magnitude := "", ""
+		if m, ok := recordFloat(hypocenter, "magnitude"); ok && m >= 0 {
+			magType, magnitude = "Mj", fmt.Sprint(m)
+		}
+
+		fields := []string{
+			recordID(item),
+			originTime,
+			fmt.Sprint(latitude),
+			fmt.Sprint(longitude),
+			depth,
+			"JMA",
+			"JMA",
+			"JMA",
+			quakeMLResourcePrefix + "/" + recordID(item),
+			magType,
+			magnitude,
+			"JMA",
+			strings.ReplaceAll(recordString(hypocenter, "name"), "|", " "),
+		}
+		b.WriteString(strings.Join(fields, "|"))
+		b.WriteString("\n")
+	}
+
+	c.Data(200, "text/plain; charset=utf-8", []byte(b.String()))
+}
+
+func fdsnError(c *gin.Context, status int, detail string) {
+	requestURL := url.URL{Path: c.Request.URL.Path, RawQuery: c.Request.URL.RawQuery}
+	body := fmt.Sprintf("Error %d: %s\n\n%s\n\nRequest:\n%s\n\nRequest Submitted:\n%s\n\nService version:\n%s\n",
+		status, http.StatusText(status), detail, requestURL.String(), time.Now().UTC().Format("2006-01-02T15:04:05"), fdsnServiceVersion)
+	// 本文に日時を含むため、リクエストの記録ではボディを比較しない.
+	recording.SkipHash(c)
+	c.Data(status, "text/plain; charset=utf-8", []byte(body))
+}
+
+func parseFDSNTime(s string) (time.Time, error) {
+	layouts := []string{"2006-01-02T15:04:05.999999", "2006-01-02T15:04:05", "2006-01-02"}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSuffix(s, "Z"), time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s", s)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestNormalizeFDSNQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    url.Values
+		wantErr bool
+	}{
+		{"no alias", "minmagnitude=3", url.Values{"minmagnitude": {"3"}}, false},
+		{"aliases", "start=2024-01-01&end=2024-01-02&minlat=30&maxlat=40&minlon=130&maxlon=140&minmag=3&maxmag=7&magtype=mj",
+			url.Values{
+				"starttime": {"2024-01-01"}, "endtime": {"2024-01-02"},
+				"minlatitude": {"30"}, "maxlatitude": {"40"}, "minlongitude": {"130"}, "maxlongitude": {"140"},
+				"minmagnitude": {"3"}, "maxmagnitude": {"7"}, "magnitudetype": {"mj"},
+			}, false},
+		{"alias and name", "minmag=3&minmagnitude=4", url.Values{"minmagnitude": {"4", "3"}}, false},
+		{"unsupported", "latitude=35", nil, true},
+		{"unsupported alias", "lon=135", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _ := url.ParseQuery(tt.query)
+			err := normalizeFDSNQuery(query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeFDSNQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(query, tt.want) {
+				t.Errorf("normalizeFDSNQuery() = %v, want %v", query, tt.want)
+			}
+		})
+	}
+}
+
+func TestFDSNEventPipelineOffset(t *testing.T) {
+	tests := []struct {
+		offset int64
+		want   int64
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 1},
+		{11, 10},
+	}
+
+	for _, tt := range tests {
+		pipeline, err := fdsnEventPipeline(FDSNEventParam{Offset: tt.offset})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if skip := stageValue(t, pipeline, "$skip"); skip != tt.want {
+			t.Errorf("offset %d: $skip = %v, want %d", tt.offset, skip, tt.want)
+		}
+	}
+}
+
+func TestFDSNEventPipelineNoData(t *testing.T) {
+	tests := []struct {
+		name  string
+		param FDSNEventParam
+	}{
+		{"magnitude type", FDSNEventParam{MagnitudeType: "mb"}},
+		{"event type", FDSNEventParam{EventType: "explosion"}},
+		{"catalog", FDSNEventParam{Catalog: "ISC"}},
+		{"contributor", FDSNEventParam{Contributor: "USGS"}},
+		{"event id", FDSNEventParam{EventID: "not-an-object-id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := fdsnEventPipeline(tt.param); err != errFDSNNoData {
+				t.Errorf("fdsnEventPipeline() error = %v, want errFDSNNoData", err)
+			}
+		})
+	}
+
+	if _, err := fdsnEventPipeline(FDSNEventParam{MagnitudeType: "MJ", EventType: "earthquake", Catalog: "JMA"}); err != nil {
+		t.Errorf("fdsnEventPipeline() error = %v, want nil", err)
+	}
+}
+
+// TestFDSNEventPipelineFiltersLatestIssuance は震源の条件を、地震ごとにまとめた最新の情報に対して絞り込むことを検証する.
+func TestFDSNEventPipelineFiltersLatestIssuance(t *testing.T) {
+	minDepth, maxLatitude := 10.0, 40.0
+	pipeline, err := fdsnEventPipeline(FDSNEventParam{
+		StartTime:    "2024-01-01T00:00:00",
+		MinMagnitude: 4.5,
+		MinDepth:     &minDepth,
+		MaxLatitude:  &maxLatitude,
+		EventID:      "659262b0c4ddcb001e9a1f73",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replaced := false
+	for _, stage := range pipeline {
+		switch stage[0].Key {
+		case "$replaceRoot":
+			replaced = true
+		case "$match":
+			for _, filter := range stage[0].Value.(bson.D) {
+				isEventFilter := strings.HasPrefix(filter.Key, "earthquake.hypocenter.") || filter.Key == "_id"
+				if isEventFilter && !replaced {
+					t.Errorf("%s is filtered before grouping", filter.Key)
+				}
+				if filter.Key == "earthquake.time" && replaced {
+					t.Errorf("earthquake.time is filtered after grouping")
+				}
+			}
+		}
+	}
+	if !replaced {
+		t.Fatal("pipeline does not group issuances")
+	}
+}
+
+// TestQueryFDSNEventsSupersededIssuance は、続報で条件を満たさなくなった地震を返却しないことを検証する.
+// TEST_MONGODB_URL を指定した場合のみ実行する.
+func TestQueryFDSNEventsSupersededIssuance(t *testing.T) {
+	if !setupContractDatabase(t) {
+		t.Skip("TEST_MONGODB_URL is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	issuances := []bson.M{
+		{"code": 551, "time": "2001/02/03 04:06:00.000", "issue": bson.M{"source": "気象庁", "time": "2001/02/03 04:06:00", "type": "Destination", "correct": "None"},
+			"earthquake": bson.M{"time": "2001/02/03 04:05:00", "maxScale": -1, "domesticTsunami": "None", "foreignTsunami": "Unknown",
+				"hypocenter": bson.M{"name": "石川県能登地方", "latitude": 37.5, "longitude": 137.2, "depth": 10, "magnitude": 5.0}}},
+		{"code": 551, "time": "2001/02/03 04:10:00.000", "issue": bson.M{"source": "気象庁", "time": "2001/02/03 04:10:00", "type": "DetailScale", "correct": "None"},
+			"earthquake": bson.M{"time": "2001/02/03 04:05:00", "maxScale": 30, "domesticTsunami": "None", "foreignTsunami": "Unknown",
+				"hypocenter": bson.M{"name": "石川県能登地方", "latitude": 37.5, "longitude": 137.2, "depth": 10, "magnitude": 4.2}}},
+	}
+	for _, issuance := range issuances {
+		if _, err := jmaCollection.InsertOne(ctx, issuance); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r)
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantLines  int
+	}{
+		{"starttime=2001-02-02T19:00:00&endtime=2001-02-02T20:00:00&minmag=4.5&format=text", 204, 0},
+		{"starttime=2001-02-02T19:00:00&endtime=2001-02-02T20:00:00&minmag=4.5&format=text&nodata=404", 404, 0},
+		{"starttime=2001-02-02T19:00:00&endtime=2001-02-02T20:00:00&minmag=4.0&format=text", 200, 1},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/fdsnws/event/1/query?"+tt.query, nil))
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d (%s)", tt.query, w.Code, tt.wantStatus, w.Body.String())
+			continue
+		}
+		if tt.wantStatus != 200 {
+			continue
+		}
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")[1:]
+		if len(lines) != tt.wantLines || !strings.Contains(lines[0], "|4.2|") {
+			t.Errorf("%s: events = %q, want 1 event with M4.2", tt.query, lines)
+		}
+	}
+}
+
+// TestQueryFDSNEventsNoData は検索せずに該当なしと分かる場合に、 nodata で指定したステータスコードを返却することを検証する.
+func TestQueryFDSNEventsNoData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r)
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"magtype=mb", 204},
+		{"magtype=mb&nodata=404", 404},
+		{"eventid=invalid&nodata=404", 404},
+		{"nodata=500", 400},
+		{"lat=35", 400},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/fdsnws/event/1/query?"+tt.query, nil))
+		if w.Code != tt.want {
+			t.Errorf("%s: status = %d, want %d (%s)", tt.query, w.Code, tt.want, w.Body.String())
+		}
+	}
+}
+
+func stageValue(t *testing.T, pipeline []bson.D, name string) interface{} {
+	for _, stage := range pipeline {
+		if stage[0].Key == name {
+			return stage[0].Value
+		}
+	}
+	t.Fatalf("pipeline has no %s stage", name)
+	return nil
+}
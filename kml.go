@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// 気象庁の震度階級の配色 (RGB).
+var kmlScaleColors = map[int]string{
+	10: "f2f2ff",
+	20: "00aaff",
+	30: "0041ff",
+	40: "fae696",
+	45: "ffe600",
+	46: "ffe600",
+	50: "ff9900",
+	55: "ff2800",
+	60: "a50021",
+	70: "b40068",
+}
+
+type kmlDocument struct {
+	XMLName  xml.Name  `xml:"kml"`
+	XMLNS    string    `xml:"xmlns,attr"`
+	Document kmlFolder `xml:"Document"`
+}
+
+type kmlFolder struct {
+	Name        string         `xml:"name"`
+	Description string         `xml:"description,omitempty"`
+	Placemarks  []kmlPlacemark `xml:"Placemark"`
+	Folders     []kmlFolder    `xml:"Folder"`
+}
+
+type kmlPlacemark struct {
+	Name        string        `xml:"name"`
+	Description string        `xml:"description,omitempty"`
+	TimeStamp   *kmlTimeStamp `xml:"TimeStamp,omitempty"`
+	Style       *kmlStyle     `xml:"Style,omitempty"`
+	Point       *kmlPoint     `xml:"Point,omitempty"`
+}
+
+type kmlTimeStamp struct {
+	When string `xml:"when"`
+}
+
+type kmlStyle struct {
+	IconStyle kmlIconStyle `xml:"IconStyle"`
+}
+
+type kmlIconStyle struct {
+	Color string  `xml:"color"`
+	Scale float64 `xml:"scale"`
+	Icon  kmlIcon `xml:"Icon"`
+}
+
+type kmlIcon struct {
+	Href string `xml:"href"`
+}
+
+type kmlPoint struct {
+	Coordinates string `xml:"coordinates"`
+}
+
+func renderKML(c *gin.Context, items []bson.M) {
+	document := kmlDocument{
+		XMLNS: "http://www.opengis.net/kml/2.2",
+		Document: kmlFolder{
+			Name:    "P2P地震情報 地震情報",
+			Folders: make([]kmlFolder, 0, len(items)),
+		},
+	}
+	for _, item := range items {
+		document.Document.Folders = append(document.Document.Folders, toKMLFolder(item))
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		respondProblem(c, 500, "response encoding error")
+		return
+	}
+	c.Data(200, "application/vnd.google-earth.kml+xml; charset=utf-8", append([]byte(xml.Header), body...))
+}
+
+func toKMLFolder(item bson.M) kmlFolder {
+	earthquake := recordMap(item, "earthquake")
+	hypocenter := recordMap(item, "earthquake.hypocenter")
+	issue := recordMap(item, "issue")
+
+	originTime := recordString(earthquake, "time")
+	maxScale, _ := recordInt(earthquake, "maxScale")
+	name := recordString(hypocenter, "name")
+	if name == "" {
+		name = "震源情報なし"
+	}
+	magnitude, ok := recordFloat(hypocenter, "magnitude")
+	if !ok {
+		magnitude = -1
+	}
+
+	folder := kmlFolder{
+		Name:        fmt.Sprintf("%s %s", originTime, name),
+		Description: fmt.Sprintf("ID: %s\n発表種類: %s", recordID(item), recordString(issue, "type")),
+	}
+
+	var timeStamp *kmlTimeStamp
+	if t, err := parseJSTTime(originTime); err == nil {
+		timeStamp = &kmlTimeStamp{When: t.UTC().Format(time.RFC3339)}
+	}
+
+	latitude, okLatitude := recordFloat(hypocenter, "latitude")
+	longitude, okLongitude := recordFloat(hypocenter, "longitude")
+	// 震源情報が存在しない場合、緯度・経度は -200 となる.
+	if okLatitude && okLongitude && latitude > -200 && longitude > -200 {
+		description := fmt.Sprintf("発生日時: %s\n最大震度: %s", originTime, scaleNames[maxScale])
+		if magnitude >= 0 {
+			description += fmt.Sprintf("\nマグニチュード: %.1f", magnitude)
+		}
+		if depth, ok := recordFloat(hypocenter, "depth"); ok && depth >= 0 {
+			description += fmt.Sprintf("\n深さ: %dkm", int(depth))
+		}
+
+		folder.Placemarks = append(folder.Placemarks, kmlPlacemark{
+			Name:        name,
+			Description: description,
+			TimeStamp:   timeStamp,
+			Style: &kmlStyle{IconStyle: kmlIconStyle{
+				Color: kmlColor(maxScale),
+				Scale: kmlMagnitudeScale(magnitude),
+				Icon:  kmlIcon{Href: "http://maps.google.com/mapfiles/kml/shapes/placemark_circle.png"},
+			}},
+			Point: &kmlPoint{Coordinates: fmt.Sprintf("%v,%v,0", longitude, latitude)},
+		})
+	}
+
+	points := recordArray(item, "points")
+	if len(points) > 0 {
+		pointFolder := kmlFolder{Name: "震度観測点"}
+		for _, point := range points {
+			scale, _ := recordInt(point, "scale")
+			placemark := kmlPlacemark{
+				Name:        fmt.Sprintf("%s %s", recordString(point, "addr"), scaleNames[scale]),
+				Description: recordString(point, "pref"),
+				TimeStamp:   timeStamp,
+			}
+			// 震度速報の区域は、対応する地域の代表点に置く.
+			// 震度観測点や対応する地域のない区域は座標を持たないため、ジオメトリのない Placemark として一覧のみ提供する.
+			isArea, _ := point["isArea"].(bool)
+			if centroid, ok := kmlAreaCentroid(recordString(point, "pref"), recordString(point, "addr")); isArea && ok {
+				placemark.Style = &kmlStyle{IconStyle: kmlIconStyle{
+					Color: kmlColor(scale),
+					Scale: 0.6,
+					Icon:  kmlIcon{Href: "http://maps.google.com/mapfiles/kml/shapes/square.png"},
+				}}
+				placemark.Point = &kmlPoint{Coordinates: fmt.Sprintf("%v,%v,0", centroid.Longitude, centroid.Latitude)}
+			}
+			pointFolder.Placemarks = append(pointFolder.Placemarks, placemark)
+		}
+		folder.Folders = append(folder.Folders, pointFolder)
+	}
+
+	return folder
+}
+
+// kmlAreaCentroid は震度速報の区域 ("石川県能登" など) を含む地域の代表点を返す.
+func kmlAreaCentroid(prefecture string, name string) (userquake.Coordinate, bool) {
+	area, ok := userquake.Areas.ByScaleArea(prefecture, name)
+	if !ok {
+		return userquake.Coordinate{}, false
+	}
+	return userquake.Centroid(area.Code)
+}
+
+// kmlColor は震度に応じた KML の色 (aabbggrr) を返す.
+func kmlColor(scale int) string {
+	rgb, ok := kmlScaleColors[scale]
+	if !ok {
+		return "ff999999"
+	}
+	return "ff" + rgb[4:6] + rgb[2:4] + rgb[0:2]
+}
+
+// kmlMagnitudeScale はマグニチュードに応じたアイコンの大きさを返す.
+func kmlMagnitudeScale(magnitude float64) float64 {
+	if magnitude < 0 {
+		return 1.0
+	}
+	return math.Round(math.Max(0.5, 0.5+magnitude*0.3)*10) / 10
+}
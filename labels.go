@@ -0,0 +1,79 @@
+package main
+
+var scaleNames = map[int]string{
+	-1: "震度情報なし",
+	10: "震度1",
+	20: "震度2",
+	30: "震度3",
+	40: "震度4",
+	45: "震度5弱",
+	46: "震度5弱以上（推定）",
+	50: "震度5強",
+	55: "震度6弱",
+	60: "震度6強",
+	70: "震度7",
+}
+
+// englishScaleNames は震度の英語表記. 気象庁の英語表記にならい "5 lower" のように表す.
+var englishScaleNames = map[int]string{
+	-1: "No intensity information",
+	10: "1",
+	20: "2",
+	30: "3",
+	40: "4",
+	45: "5 lower",
+	46: "5 lower or higher (estimated)",
+	50: "5 upper",
+	55: "6 lower",
+	60: "6 upper",
+	70: "7",
+}
+
+// englishIssueTypes は発表種類 (issue.type) の英語表記.
+var englishIssueTypes = map[string]string{
+	"ScalePrompt":         "Seismic intensity report",
+	"Destination":         "Hypocenter information",
+	"ScaleAndDestination": "Hypocenter and seismic intensity information",
+	"DetailScale":         "Seismic intensity information for each location",
+	"Foreign":             "Information on earthquakes outside Japan",
+	"Other":               "Other information",
+	"Focus":               "Tsunami forecast",
+}
+
+// englishTsunamiGrades は津波予報の種類 (areas[].grade) の英語表記.
+var englishTsunamiGrades = map[string]string{
+	"MajorWarning": "Major tsunami warning",
+	"Warning":      "Tsunami warning",
+	"Watch":        "Tsunami advisory",
+	"Unknown":      "Unknown",
+}
+
+// englishDomesticTsunami は国内への津波の有無 (earthquake.domesticTsunami) の英語表記.
+var englishDomesticTsunami = map[string]string{
+	"None":         "No tsunami",
+	"Unknown":      "Unknown",
+	"Checking":     "Under investigation",
+	"NonEffective": "Slight sea level change expected, no damage",
+	"Watch":        "Tsunami advisory",
+	"Warning":      "Tsunami forecast (type unknown)",
+}
+
+// englishForeignTsunami は海外での津波の有無 (earthquake.foreignTsunami) の英語表記.
+var englishForeignTsunami = map[string]string{
+	"None":               "No tsunami",
+	"Unknown":            "Unknown",
+	"Checking":           "Under investigation",
+	"NonEffectiveNearby": "Possible small tsunami near the epicenter, no damage",
+	"WarningNearby":      "Possible tsunami near the epicenter",
+	"WarningPacific":     "Possible tsunami in the Pacific",
+	"WarningPacificWide": "Possible tsunami across a wide area of the Pacific",
+	"WarningIndian":      "Possible tsunami in the Indian Ocean",
+	"WarningIndianWide":  "Possible tsunami across a wide area of the Indian Ocean",
+	"Potential":          "Tsunami generally possible for an earthquake of this size",
+}
+
+// englishEEWDetectionTypes は緊急地震速報 発表検出の検出種類 (type) の英語表記.
+var englishEEWDetectionTypes = map[string]string{
+	"Full":  "Chime and voice",
+	"Chime": "Chime only",
+}
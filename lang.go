@@ -0,0 +1,187 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// langEnglish は英語の表記を付加する lang パラメタの値.
+// 既存のフィールドは変更せず、 JMA の情報などキャメルケースのものには "En" 、それ以外には "_en" を付けたフィールドを加える.
+const langEnglish = "en"
+
+type LangParam struct {
+	Lang string `form:"lang"`
+}
+
+// localizeRecord は情報コードに応じて、発表種類・震度・津波予報の種類・地域名などの英語表記を付加する.
+func localizeRecord(m primitive.M) {
+	code, _ := recordInt(m, "code")
+	switch code {
+	case 551:
+		setEnglishLabel(recordMap(m, "issue"), "type", englishIssueTypes)
+		if earthquake := recordMap(m, "earthquake"); earthquake != nil {
+			setEnglishScale(earthquake, "maxScale")
+			setEnglishLabel(earthquake, "domesticTsunami", englishDomesticTsunami)
+			setEnglishLabel(earthquake, "foreignTsunami", englishForeignTsunami)
+		}
+		for _, point := range recordArray(m, "points") {
+			if name, ok := userquake.EnglishPrefecture(recordString(point, "pref")); ok {
+				point["prefEn"] = name
+			}
+			setEnglishScale(point, "scale")
+		}
+	case 552:
+		setEnglishLabel(recordMap(m, "issue"), "type", englishIssueTypes)
+		for _, area := range recordArray(m, "areas") {
+			setEnglishLabel(area, "grade", englishTsunamiGrades)
+		}
+	case 561:
+		if area, ok := recordInt(m, "area"); ok {
+			if name, ok := userquake.EnglishName(area); ok {
+				m["areaEn"] = name
+			}
+		}
+	case 9611:
+		areaConfidences := recordMap(m, "area_confidences")
+		for key := range areaConfidences {
+			code, err := strconv.Atoi(key)
+			if err != nil {
+				continue
+			}
+			if name, ok := userquake.EnglishName(code); ok {
+				if confidence := recordMap(areaConfidences, key); confidence != nil {
+					confidence["name_en"] = name
+				}
+			}
+		}
+	}
+}
+
+func setEnglishLabel(m primitive.M, key string, labels map[string]string) {
+	if m == nil {
+		return
+	}
+	if label, ok := labels[recordString(m, key)]; ok {
+		m[key+"En"] = label
+	}
+}
+
+func setEnglishScale(m primitive.M, key string) {
+	if scale, ok := recordInt(m, key); ok {
+		if label, ok := englishScaleNames[scale]; ok {
+			m[key+"En"] = label
+		}
+	}
+}
+
+// localizeUserquakeEvent は地震感知情報のまとまりに、地方・都道府県・地域ごとの件数を英語表記で付加する.
+func localizeUserquakeEvent(event primitive.M) {
+	event["regions_en"] = englishCounts(event["regions"], userquake.EnglishRegion)
+	event["prefs_en"] = englishCounts(event["prefs"], userquake.EnglishPrefecture)
+	event["areas_en"] = englishCounts(event["areas"], englishAreaName)
+
+	if locations, ok := event["locations"].([]primitive.M); ok {
+		for _, location := range locations {
+			if code, ok := location["area"].(int); ok {
+				if name, ok := userquake.EnglishName(code); ok {
+					location["name_en"] = name
+				}
+			}
+		}
+	}
+}
+
+// englishCounts は名前ごとの件数を、英語表記ごとの件数に置き換える. 英語表記がない名前はそのまま残す.
+func englishCounts(value interface{}, english func(string) (string, bool)) map[string]int {
+	counts := map[string]int{}
+	m, _ := value.(map[string]int)
+	for name, count := range m {
+		if label, ok := english(name); ok {
+			name = label
+		}
+		counts[name] += count
+	}
+	return counts
+}
+
+func englishAreaName(name string) (string, bool) {
+	area, ok := userquake.Areas.ByName(name)
+	if !ok {
+		return "", false
+	}
+	return userquake.EnglishName(area.Code)
+}
+
+// localizeAreapeers は地域ごとのピア数に、地方・都道府県・地域名の英語表記を付加する.
+func localizeAreapeers(summary primitive.M) {
+	summary["regions_en"] = englishCounts(summary["regions"], userquake.EnglishRegion)
+	summary["prefs_en"] = englishCounts(summary["prefs"], userquake.EnglishPrefecture)
+
+	areas, _ := summary["areas"].([]primitive.M)
+	for _, item := range areas {
+		code, _ := item["id"].(int)
+		if area, ok := userquake.Areas.ByCode(code); ok {
+			item["region_en"], _ = userquake.EnglishRegion(area.Region)
+			item["prefecture_en"], _ = userquake.EnglishPrefecture(area.Prefecture)
+			item["name_en"], _ = userquake.EnglishName(area.Code)
+		}
+	}
+}
+
+// setSnakeEnglishLabel は setEnglishLabel と同じく英語表記を付加する. snake_case のオブジェクトに用い、 "_en" を付けたフィールドとする.
+func setSnakeEnglishLabel(m primitive.M, key string, labels map[string]string) {
+	if label, ok := labels[recordString(m, key)]; ok {
+		m[key+"_en"] = label
+	}
+}
+
+// setSnakeEnglishScale は震度の英語表記を "_en" を付けたフィールドとして付加する.
+func setSnakeEnglishScale(m primitive.M, key string) {
+	if scale, ok := recordInt(m, key); ok {
+		if label, ok := englishScaleNames[scale]; ok {
+			m[key+"_en"] = label
+		}
+	}
+}
+
+// englishScaleCounts は震度の値 ("10" など) ごとの件数を、震度の英語表記ごとの件数に置き換える.
+func englishScaleCounts(value interface{}) map[string]int {
+	counts := map[string]int{}
+	m, _ := value.(primitive.M)
+	for key := range m {
+		count, _ := recordInt(m, key)
+		label := key
+		if scale, err := strconv.Atoi(key); err == nil {
+			if name, ok := englishScaleNames[scale]; ok {
+				label = name
+			}
+		}
+		counts[label] += count
+	}
+	return counts
+}
+
+// localizeEEWDetection は緊急地震速報の発表検出に、検出種類と、続く地震情報・地震感知情報のまとまりの英語表記を付加する.
+func localizeEEWDetection(item primitive.M) {
+	setSnakeEnglishLabel(item, "type", englishEEWDetectionTypes)
+	quakes, _ := item["quakes"].([]primitive.M)
+	for _, quake := range quakes {
+		setSnakeEnglishLabel(quake, "type", englishIssueTypes)
+		setSnakeEnglishScale(quake, "max_scale")
+	}
+	if event, ok := item["userquake_event"].(primitive.M); ok {
+		localizeUserquakeEvent(event)
+	}
+}
+
+// localizeStationObservation は震度観測点の観測履歴に、発表種類・震度・都道府県の英語表記を付加する.
+func localizeStationObservation(observation primitive.M) {
+	setSnakeEnglishLabel(observation, "type", englishIssueTypes)
+	setSnakeEnglishScale(observation, "scale")
+	setSnakeEnglishScale(observation, "max_scale")
+	if name, ok := userquake.EnglishPrefecture(recordString(observation, "prefecture")); ok {
+		observation["prefecture_en"] = name
+	}
+}
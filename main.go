@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"math"
 	"reflect"
 	"regexp"
 	"sort"
@@ -12,9 +13,9 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/recording"
 	"github.com/p2pquake/web-api-v2/userquake"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -27,87 +28,146 @@ type Config struct {
 	Database          string `envconfig:"database"`
 	JmaCollection     string `envconfig:"jma_collection"`
 	HistoryCollection string `envconfig:"history_collection"`
+	PublicURL         string `envconfig:"public_url" default:"https://api.p2pquake.net"`
+	RecordFile        string `envconfig:"record_file"`
+	// 地域の境界 (GeoJSON). 指定しない場合、 /v2/areas.geojson は同梱の近似的な境界を返却する.
+	AreaBoundariesFile string `envconfig:"area_boundaries_file"`
+	// 地震感知情報をまとめる条件. 重みは "250:0.5,275:2" のように地域コードと重みを指定する.
+	UserquakeGap         time.Duration   `envconfig:"userquake_gap" default:"30s"`
+	UserquakeMinCount    float64         `envconfig:"userquake_min_count" default:"3"`
+	UserquakeMaxDuration time.Duration   `envconfig:"userquake_max_duration" default:"0s"`
+	UserquakeAreaWeights map[int]float64 `envconfig:"userquake_area_weights"`
+	// 緊急地震速報の発表検出に続く地震情報・地震感知情報を探す時間幅.
+	EEWFollowWindow time.Duration `envconfig:"eew_follow_window" default:"10m"`
+	// 震度観測点の一覧 (/v2/stations) を作り直す間隔.
+	StationCatalogTTL time.Duration `envconfig:"station_catalog_ttl" default:"10m"`
 }
 
 type HumanReadableParam struct {
 	Limit int64 `form:"limit" binding:"min=0,max=100"`
 }
 
+// QuakeParam, TsunamiParam, HistoryParam の値は validateRequest で仕様書に従って検証済みのため、ここでは検証しない.
 type QuakeParam struct {
-	Offset       int64    `form:"offset" binding:"min=0"`
-	Limit        int64    `form:"limit" binding:"min=0,max=100"`
-	Order        int64    `form:"order" binding:"min=-1,max=1"`
-	QuakeType    string   `form:"quake_type" binding:"omitempty,quaketype"`
-	MinScale     int64    `form:"min_scale" binding:"omitempty,scale"`
-	MaxScale     int64    `form:"max_scale" binding:"omitempty,scale"`
-	MinMagnitude float64  `form:"min_magnitude" binding:"min=0.0"`
-	MaxMagnitude float64  `form:"max_magnitude" binding:"min=0.0"`
-	SinceDate    string   `form:"since_date" binding:"omitempty,numeric,len=8"`
-	UntilDate    string   `form:"until_date" binding:"omitempty,numeric,len=8"`
-	Prefectures  []string `form:"prefectures[]" binding:"omitempty,dive,contains=0x2C"`
+	Offset       int64    `form:"offset"`
+	Limit        int64    `form:"limit"`
+	Order        int64    `form:"order"`
+	QuakeType    string   `form:"quake_type"`
+	MinScale     int64    `form:"min_scale"`
+	MaxScale     int64    `form:"max_scale"`
+	MinMagnitude float64  `form:"min_magnitude"`
+	MaxMagnitude float64  `form:"max_magnitude"`
+	SinceDate    string   `form:"since_date"`
+	UntilDate    string   `form:"until_date"`
+	Prefectures  []string `form:"prefectures[]"`
+	Format       string   `form:"format"`
+	Lang         string   `form:"lang"`
 }
 
 type TsunamiParam struct {
-	Offset    int64  `form:"offset" binding:"min=0"`
-	Limit     int64  `form:"limit" binding:"min=0,max=100"`
-	Order     int64  `form:"order" binding:"min=-1,max=1"`
-	SinceDate string `form:"since_date" binding:"omitempty,numeric,len=8"`
-	UntilDate string `form:"until_date" binding:"omitempty,numeric,len=8"`
+	Offset    int64  `form:"offset"`
+	Limit     int64  `form:"limit"`
+	Order     int64  `form:"order"`
+	SinceDate string `form:"since_date"`
+	UntilDate string `form:"until_date"`
+	Lang      string `form:"lang"`
 }
 
 type HistoryParam struct {
-	Codes  []int64 `form:"codes" binding:"omitempty,dive,numeric"`
-	Offset int64   `form:"offset" binding:"min=0"`
-	Limit  int64   `form:"limit" binding:"min=0,max=100"`
+	Codes  []int64 `form:"codes"`
+	Offset int64   `form:"offset"`
+	Limit  int64   `form:"limit"`
+	Lang   string  `form:"lang"`
 }
 
 var jmaCollection *mongo.Collection
 var historyCollection *mongo.Collection
 
-func validQuakeType(fl validator.FieldLevel) bool {
-	if quakeType, ok := fl.Field().Interface().(string); ok {
-		if quakeType == "ScalePrompt" || quakeType == "Destination" ||
-			quakeType == "ScaleAndDestination" || quakeType == "DetailScale" ||
-			quakeType == "Foreign" || quakeType == "Other" {
-			return true
-		}
+// userquakeClusterOptions は地震感知情報をまとめる条件. Config の USERQUAKE_* で変更できる.
+var userquakeClusterOptions = userquake.DefaultClusterOptions
+
+func (p *QuakeParam) validateCrossFields() []InvalidParam {
+	var invalidParams []InvalidParam
+	if p.SinceDate != "" && p.UntilDate != "" && p.SinceDate > p.UntilDate {
+		invalidParams = append(invalidParams, orderedFieldError("since_date", "until_date", p.SinceDate))
+	}
+	if p.MinScale != 0 && p.MaxScale != 0 && p.MinScale > p.MaxScale {
+		invalidParams = append(invalidParams, orderedFieldError("min_scale", "max_scale", p.MinScale))
 	}
-	return false
+	if p.MinMagnitude != 0.0 && p.MaxMagnitude != 0.0 && p.MinMagnitude > p.MaxMagnitude {
+		invalidParams = append(invalidParams, orderedFieldError("min_magnitude", "max_magnitude", p.MinMagnitude))
+	}
+	return invalidParams
 }
 
-func validScale(fl validator.FieldLevel) bool {
-	if scale, ok := fl.Field().Interface().(int64); ok {
-		if scale == 10 || scale == 20 || scale == 30 || scale == 40 ||
-			scale == 45 || scale == 50 || scale == 55 || scale == 60 || scale == 70 {
-			return true
-		}
+func (p *TsunamiParam) validateCrossFields() []InvalidParam {
+	var invalidParams []InvalidParam
+	if p.SinceDate != "" && p.UntilDate != "" && p.SinceDate > p.UntilDate {
+		invalidParams = append(invalidParams, orderedFieldError("since_date", "until_date", p.SinceDate))
 	}
-	return false
+	return invalidParams
 }
 
 func validateQueryParams(c *gin.Context, paramStruct interface{}) []string {
 	allowedParams := make(map[string]bool)
+	for _, name := range formTags(paramStruct) {
+		allowedParams[name] = true
+	}
+
+	var extraKeys []string
+	for param := range c.Request.URL.Query() {
+		if !allowedParams[param] {
+			extraKeys = append(extraKeys, param)
+		}
+	}
 
+	return extraKeys
+}
+
+// formTags はパラメタ構造体の form タグに記載されたクエリパラメタの名前を返す.
+func formTags(paramStruct interface{}) []string {
 	t := reflect.TypeOf(paramStruct)
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
 
+	var names []string
 	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		if formTag := field.Tag.Get("form"); formTag != "" {
-			allowedParams[formTag] = true
+		if formTag := t.Field(i).Tag.Get("form"); formTag != "" {
+			names = append(names, formTag)
 		}
 	}
+	return names
+}
 
-	var extraKeys []string
-	for param := range c.Request.URL.Query() {
-		if !allowedParams[param] {
-			extraKeys = append(extraKeys, param)
+// recordableQueryParams はリクエストの記録に値を残してよいクエリパラメタの名前を返す.
+// /v2 は仕様書に記載されたパラメタ、それ以外はパラメタ構造体に定義されたパラメタとする.
+func recordableQueryParams(method string, path string) []string {
+	switch path {
+	case "/v1/human-readable":
+		return formTags(HumanReadableParam{})
+	case "/fdsnws/event/1/query":
+		names := formTags(FDSNEventParam{})
+		for alias := range fdsnParamAliases {
+			names = append(names, alias)
 		}
+		return names
 	}
 
-	return extraKeys
+	if !strings.HasPrefix(path, "/v2/") {
+		return nil
+	}
+	operation, _ := openAPIDocument.FindOperation(method, strings.TrimPrefix(path, "/v2"))
+	if operation == nil {
+		return nil
+	}
+	var names []string
+	for _, parameter := range operation.Parameters {
+		if parameter.In == "query" {
+			names = append(names, parameter.Name)
+		}
+	}
+	return names
 }
 
 func main() {
@@ -117,6 +177,25 @@ func main() {
 		log.Fatalf("config parse error: %v", err)
 	}
 
+	if err := loadOpenAPI(config.PublicURL); err != nil {
+		log.Fatalf("openapi load error: %v", err)
+	}
+
+	userquakeClusterOptions = userquake.ClusterOptions{
+		Gap:         config.UserquakeGap,
+		MinCount:    config.UserquakeMinCount,
+		MaxDuration: config.UserquakeMaxDuration,
+		AreaWeights: config.UserquakeAreaWeights,
+	}
+	eewFollowWindow = config.EEWFollowWindow
+	stationCatalogTTL = config.StationCatalogTTL
+
+	if config.AreaBoundariesFile != "" {
+		if err := loadAreaBoundaries(config.AreaBoundariesFile); err != nil {
+			log.Fatalf("area boundaries load error: %v", err)
+		}
+	}
+
 	clientOptions := options.Client().ApplyURI(config.MongoDBURL)
 	client, err := mongo.NewClient(clientOptions)
 	if err != nil {
@@ -136,42 +215,78 @@ func main() {
 	historyCollection = client.Database(config.Database).Collection(config.HistoryCollection)
 
 	r := gin.Default()
-	r.Use(cors.Default())
 
-	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-		v.RegisterValidation("quaketype", validQuakeType)
-		v.RegisterValidation("scale", validScale)
+	// 回帰テスト用にリクエストを記録する (cmd/replay で再生する).
+	if config.RecordFile != "" {
+		recorder, err := recording.Open(config.RecordFile, recordableQueryParams)
+		if err != nil {
+			log.Fatalf("record file open error: %v", err)
+		}
+		defer recorder.Close()
+		r.Use(recorder.Middleware())
 	}
 
+	setupRoutes(r)
+	r.Run()
+}
+
+// setupRoutes は API のルーティングを設定する. main とコントラクトテストで共通に用いる.
+func setupRoutes(r *gin.Engine) {
+	r.Use(cors.Default())
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(respondNoRoute)
+	r.NoMethod(respondNoMethod)
+
 	v1 := r.Group("/v1")
 	{
 		v1.GET("/human-readable", getHumanReadable)
 	}
 
 	v2 := r.Group("/v2")
+	v2.Use(validateRequest("/v2"))
 	{
 		jma := v2.Group("/jma")
 		{
 			jma.GET("/quake", searchQuake)
 			jma.GET("/quake/:id", getQuake)
+			jma.GET("/quake/:id/estimated_intensity", getQuakeEstimatedIntensity)
 			jma.GET("/tsunami", searchTsunami)
 			jma.GET("/tsunami/:id", getTsunami)
 		}
 
 		v2.GET("/history", getHistories)
+		v2.GET("/userquake", searchUserquakes)
+		v2.GET("/userquake/events", getUserquakeEvents)
+		v2.GET("/userquake/lead_times", getUserquakeLeadTimes)
+		v2.GET("/userquake/evaluations", searchUserquakeEvaluations)
+		v2.GET("/eew-detections", searchEEWDetections)
+		v2.GET("/stats/quakes", getQuakeStats)
+		v2.GET("/stats/prefectures", getPrefectureStats)
+		v2.GET("/stats/gutenberg_richter", getGutenbergRichter)
+		v2.GET("/stations", searchStations)
+		v2.GET("/stations/:name/observations", searchStationObservations)
+		v2.GET("/areapeers", searchAreapeers)
+		v2.GET("/areapeers/latest", getLatestAreapeers)
+		v2.GET("/areas", getAreas)
+		v2.GET("/areas.geojson", getAreasGeoJSON)
+		v2.GET("/p2pquake.proto", getProtoDefinition)
+		v2.GET("/openapi.yaml", getOpenAPIYAML)
+		v2.GET("/openapi.json", getOpenAPIJSON)
+		v2.GET("/docs", getDocs)
+	}
+
+	fdsnEvent := r.Group("/fdsnws/event/1")
+	{
+		fdsnEvent.GET("/query", queryFDSNEvents)
+		fdsnEvent.GET("/version", getFDSNVersion)
+		fdsnEvent.GET("/catalogs", getFDSNCatalogs)
+		fdsnEvent.GET("/contributors", getFDSNContributors)
 	}
-
-	r.Run()
 }
 
 func getHumanReadable(c *gin.Context) {
 	var humanReadableParam HumanReadableParam
-	if extraKeys := validateQueryParams(c, &humanReadableParam); len(extraKeys) > 0 {
-		c.JSON(400, gin.H{"error": "extra keys found", "extra_keys": extraKeys})
-		return
-	}
-	if err := c.ShouldBindWith(&humanReadableParam, binding.Query); err != nil {
-		c.Status(400)
+	if !bindQuery(c, &humanReadableParam) {
 		return
 	}
 
@@ -187,7 +302,8 @@ func getHumanReadable(c *gin.Context) {
 	filters := bson.D{{"code", bson.M{"$in": bson.A{5510, 5520}}}}
 	cur, err := historyCollection.Find(ctx, &filters, &options)
 	if err != nil {
-		c.Status(500)
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
 		return
 	}
 	defer cur.Close(ctx)
@@ -221,39 +337,34 @@ func getHumanReadable(c *gin.Context) {
 		uqCur, err := historyCollection.Find(ctx, &uqFilters)
 		if err != nil {
 			log.Printf("find error: %v\n", err)
-			c.Status(500)
+			respondProblem(c, 500, "database error")
 			return
 		}
 		defer uqCur.Close(ctx)
 
-		var uqLastTime *time.Time = nil
-		var uqLastRecords []primitive.M
 		var uqAnalyzedData []primitive.M
+		clusterer := userquake.NewClusterer(userquakeClusterOptions)
 		for uqCur.Next(ctx) {
 			var result bson.M
 			if err := uqCur.Decode(&result); err != nil {
-				c.Status(500)
+				respondProblem(c, 500, "database error")
 				return
 			}
 
-			t, err := time.Parse("2006/01/02 15:04:05", result["time"].(string)[:19])
+			t, err := parseJSTTime(recordString(result, "time"))
 			if err != nil {
-				c.Status(500)
+				respondProblem(c, 500, "invalid userquake time")
 				return
 			}
+			area, _ := recordInt(result, "area")
 
-			if uqLastTime == nil || t.Sub(*uqLastTime) >= 30*time.Second {
-				if len(uqLastRecords) >= 3 {
-					uqAnalyzedData = append(uqAnalyzedData, analyzeCollection(uqLastRecords))
-				}
-				uqLastRecords = []primitive.M{}
+			for _, cluster := range clusterer.Add(userquake.Report{Time: t, Area: area, Data: result}) {
+				uqAnalyzedData = append(uqAnalyzedData, analyzeCluster(cluster))
 			}
-			uqLastRecords = append(uqLastRecords, result)
-			uqLastTime = &t
 		}
 
-		if len(uqLastRecords) >= 3 {
-			uqAnalyzedData = append(uqAnalyzedData, analyzeCollection(uqLastRecords))
+		for _, cluster := range clusterer.Flush() {
+			uqAnalyzedData = append(uqAnalyzedData, analyzeCluster(cluster))
 		}
 
 		items = append(items, uqAnalyzedData...)
@@ -262,68 +373,112 @@ func getHumanReadable(c *gin.Context) {
 	sort.Slice(items, func(i, j int) bool { return items[i]["time"].(string) > items[j]["time"].(string) })
 	items = items[0:limit]
 
-	c.JSON(200, items)
+	respond(c, 200, items, nil)
 }
 
-func analyzeCollection(records []primitive.M) primitive.M {
+func analyzeCluster(cluster userquake.Cluster) primitive.M {
 	data := primitive.M{}
-	data["time"] = records[0]["time"]
+	data["time"] = cluster.Reports[0].Data.(bson.M)["time"]
 	data["code"] = 5610
 
-	data["count"] = len(records)
+	data["count"] = cluster.Count()
 
-	regions := map[string]int{}
-	prefs := map[string]int{}
-	areas := map[string]int{}
+	data["regions"] = cluster.Regions()
+	data["prefs"] = cluster.Prefectures()
+	data["areas"] = cluster.Areas()
 
-	for _, record := range records {
-		area := int(record["area"].(int32))
-		names, ok := userquake.GetAreaName(area)
-		if !ok {
-			continue
-		}
+	locations, centroid := areaLocations(cluster.AreaCodes())
+	data["locations"] = locations
+	if centroid != nil {
+		data["centroid"] = centroid
+	}
 
-		_, ok = regions[names[0]]
-		if !ok {
-			regions[names[0]] = 0
-		}
-		regions[names[0]] += 1
+	return data
+}
 
-		_, ok = prefs[names[1]]
-		if !ok {
-			prefs[names[1]] = 0
-		}
-		prefs[names[1]] += 1
+// areaLocations は地域ごとの件数に代表点を付与して地域コード順に返す.
+// あわせて件数で重み付けした代表点の平均を返す (代表点のある地域がなければ nil).
+func areaLocations(codes map[int]int) ([]primitive.M, primitive.M) {
+	var sortedCodes []int
+	for code := range codes {
+		sortedCodes = append(sortedCodes, code)
+	}
+	sort.Ints(sortedCodes)
 
-		_, ok = areas[names[2]]
+	locations := []primitive.M{}
+	var latitude, longitude float64
+	total := 0
+	for _, code := range sortedCodes {
+		coordinate, ok := userquake.Centroid(code)
 		if !ok {
-			areas[names[2]] = 0
+			continue
 		}
-		areas[names[2]] += 1
+		area, _ := userquake.Areas.ByCode(code)
+		count := codes[code]
+		locations = append(locations, primitive.M{
+			"area":      code,
+			"name":      area.Name,
+			"count":     count,
+			"latitude":  coordinate.Latitude,
+			"longitude": coordinate.Longitude,
+		})
+		latitude += coordinate.Latitude * float64(count)
+		longitude += coordinate.Longitude * float64(count)
+		total += count
+	}
+
+	if total == 0 {
+		return locations, nil
+	}
+	return locations, primitive.M{
+		"latitude":  math.Round(latitude/float64(total)*100) / 100,
+		"longitude": math.Round(longitude/float64(total)*100) / 100,
 	}
-
-	data["regions"] = regions
-	data["prefs"] = prefs
-	data["areas"] = areas
-
-	return data
 }
 
 func searchQuake(c *gin.Context) {
 	var quakeParam QuakeParam
-	if extraKeys := validateQueryParams(c, &quakeParam); len(extraKeys) > 0 {
-		c.JSON(400, gin.H{"error": "extra keys found", "extra_keys": extraKeys})
-		return
-	}
-	if err := c.ShouldBindWith(&quakeParam, binding.Query); err != nil {
-		c.Status(400)
+	if !bindQuery(c, &quakeParam) {
 		return
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
-	// options
+	options := quakeFindOptions(quakeParam)
+	filters := quakeFilters(quakeParam)
+
+	cur, err := jmaCollection.Find(ctx, filters, options)
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	items := make([]bson.M, 0)
+	cur.All(ctx, &items)
+
+	for _, item := range items {
+		cleanJmaRecord(item)
+		if quakeParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
+	}
+
+	switch quakeParam.Format {
+	case "quakeml":
+		renderQuakeML(c, items)
+		return
+	case "kml":
+		renderKML(c, items)
+		return
+	}
+
+	respond(c, 200, items, &pb.JMAQuakes{})
+}
+
+func quakeFindOptions(quakeParam QuakeParam) *options.FindOptions {
 	offset := quakeParam.Offset
 	limit := quakeParam.Limit
 	if limit == 0 {
@@ -333,9 +488,10 @@ func searchQuake(c *gin.Context) {
 	if order == 0 {
 		order = -1
 	}
-	options := options.FindOptions{Limit: &limit, Skip: &offset, Sort: bson.D{{"time", order}}}
+	return &options.FindOptions{Limit: &limit, Skip: &offset, Sort: bson.D{{"time", order}}}
+}
 
-	// filters
+func quakeFilters(quakeParam QuakeParam) bson.D {
 	filters := bson.D{{"code", 551}}
 
 	dateRegexp := regexp.MustCompile(`^(\d{4})(\d{2})(\d{2})$`)
@@ -371,30 +527,12 @@ func searchQuake(c *gin.Context) {
 		filters = append(filters, bson.E{"points", bson.D{{"$elemMatch", bson.D{{"pref", prefectureName}, {"scale", bson.D{{"$gte", scale}}}}}}})
 	}
 
-	cur, err := jmaCollection.Find(ctx, filters, &options)
-	if err != nil {
-		return
-	}
-	defer cur.Close(ctx)
-
-	items := make([]bson.M, 0)
-	cur.All(ctx, &items)
-
-	for _, item := range items {
-		cleanJmaRecord(item)
-	}
-
-	c.JSON(200, items)
+	return filters
 }
 
 func searchTsunami(c *gin.Context) {
 	var tsunamiParam TsunamiParam
-	if extraKeys := validateQueryParams(c, &tsunamiParam); len(extraKeys) > 0 {
-		c.JSON(400, gin.H{"error": "extra keys found", "extra_keys": extraKeys})
-		return
-	}
-	if err := c.ShouldBindWith(&tsunamiParam, binding.Query); err != nil {
-		c.Status(400)
+	if !bindQuery(c, &tsunamiParam) {
 		return
 	}
 
@@ -426,6 +564,8 @@ func searchTsunami(c *gin.Context) {
 
 	cur, err := jmaCollection.Find(ctx, filters, &options)
 	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
 		return
 	}
 	defer cur.Close(ctx)
@@ -435,39 +575,102 @@ func searchTsunami(c *gin.Context) {
 
 	for _, item := range items {
 		cleanJmaRecord(item)
+		if tsunamiParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
 	}
 
-	c.JSON(200, items)
+	respond(c, 200, items, &pb.JMATsunamis{})
 }
 
 func getQuake(c *gin.Context) {
-	getItem(c, 551)
+	var detailParam QuakeDetailParam
+	if !bindQuery(c, &detailParam) {
+		return
+	}
+
+	getItem(c, 551, func(ctx context.Context, item bson.M) error {
+		if detailParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
+		if detailParam.Include != "userquake_events" {
+			return nil
+		}
+		events, err := correlatedUserquakeEvents(ctx, item)
+		if err != nil {
+			return err
+		}
+		if detailParam.Lang == langEnglish {
+			for _, event := range events {
+				localizeUserquakeEvent(event)
+			}
+		}
+		item["userquake_events"] = events
+		return nil
+	})
 }
 
 func getTsunami(c *gin.Context) {
-	getItem(c, 552)
+	var langParam LangParam
+	if !bindQuery(c, &langParam) {
+		return
+	}
+
+	getItem(c, 552, func(ctx context.Context, item bson.M) error {
+		if langParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
+		return nil
+	})
 }
 
-func getItem(c *gin.Context, code int64) {
+// getItem は ID で指定した情報を返却する. include が指定されていれば、返却前に情報を付加する.
+func getItem(c *gin.Context, code int64, include func(ctx context.Context, item bson.M) error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
+	result, ok := findJmaItem(ctx, c, code)
+	if !ok {
+		return
+	}
+
+	if include != nil {
+		if err := include(ctx, result); err != nil {
+			log.Printf("include error: %v\n", err)
+			respondProblem(c, 500, "database error")
+			return
+		}
+	}
+
+	cleanJmaRecord(result)
+	if code == 551 {
+		respond(c, 200, result, &pb.JMAQuake{})
+	} else {
+		respond(c, 200, result, &pb.JMATsunami{})
+	}
+}
+
+// findJmaItem はパスの id で指定した情報コード code の情報を返す.
+// id が不正な場合は 400 、見つからない場合は 404 、データベースのエラーは 500 を返却して false を返す.
+func findJmaItem(ctx context.Context, c *gin.Context, code int64) (bson.M, bool) {
 	id, err := primitive.ObjectIDFromHex(c.Param("id"))
 	if err != nil {
-		c.Status(400)
-		return
+		respondProblem(c, 400, "invalid id", InvalidParam{Name: "id", Reason: "must be a 24-character hexadecimal string", Rule: "objectid", Value: c.Param("id")})
+		return nil, false
 	}
-	filters := bson.D{{"code", code}, {"_id", id}}
 
 	var result bson.M
-	err = jmaCollection.FindOne(ctx, filters).Decode(&result)
+	err = jmaCollection.FindOne(ctx, bson.D{{"code", code}, {"_id", id}}).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		respondProblem(c, 404, "item not found")
+		return nil, false
+	}
 	if err != nil {
-		c.Status(404)
-		return
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return nil, false
 	}
-
-	cleanJmaRecord(result)
-	c.JSON(200, result)
+	return result, true
 }
 
 func cleanJmaRecord(m bson.M) {
@@ -486,12 +689,7 @@ func cleanToHumanReadable(m bson.M) {
 
 func getHistories(c *gin.Context) {
 	var historyParam HistoryParam
-	if extraKeys := validateQueryParams(c, &historyParam); len(extraKeys) > 0 {
-		c.JSON(400, gin.H{"error": "extra keys found", "extra_keys": extraKeys})
-		return
-	}
-	if err := c.ShouldBindWith(&historyParam, binding.Query); err != nil {
-		c.Status(400)
+	if !bindQuery(c, &historyParam) {
 		return
 	}
 
@@ -514,7 +712,8 @@ func getHistories(c *gin.Context) {
 
 	cur, err := historyCollection.Find(ctx, &filters, &options)
 	if err != nil {
-		c.Status(500)
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
 		return
 	}
 	defer cur.Close(ctx)
@@ -524,7 +723,14 @@ func getHistories(c *gin.Context) {
 
 	for _, item := range items {
 		cleanJmaRecord(item)
+		if historyParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
 	}
 
-	c.JSON(200, items)
+	respond(c, 200, items, &pb.History{})
+}
+
+func getProtoDefinition(c *gin.Context) {
+	c.Data(200, "text/plain; charset=utf-8", pb.Proto)
 }
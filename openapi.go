@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/openapi"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed specification.yaml
+var specificationYAML []byte
+
+// ビルド時に -ldflags "-X main.version=..." で設定する.
+var version = "dev"
+
+var openAPIYAML []byte
+var openAPIJSON []byte
+var openAPIDocument *openapi.Document
+
+const docsHTML = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>P2P地震情報 API仕様書</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@4/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@4/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
+
+// loadOpenAPI は埋め込んだ仕様書の servers と info.version を実行中のビルドに合わせて書き換える.
+func loadOpenAPI(publicURL string) error {
+	var document yaml.Node
+	if err := yaml.Unmarshal(specificationYAML, &document); err != nil {
+		return err
+	}
+	if len(document.Content) == 0 {
+		return fmt.Errorf("empty specification")
+	}
+	root := document.Content[0]
+
+	if version != "dev" {
+		if info := yamlMappingValue(root, "info"); info != nil {
+			if v := yamlMappingValue(info, "version"); v != nil {
+				v.Value = version
+			}
+		}
+	}
+	if publicURL != "" {
+		if servers := yamlMappingValue(root, "servers"); servers != nil {
+			servers.Content = []*yaml.Node{{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: "url"},
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: strings.TrimSuffix(publicURL, "/") + "/v2"},
+				},
+			}}
+		}
+	}
+
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+
+	var value interface{}
+	if err := document.Decode(&value); err != nil {
+		return err
+	}
+	j, err := json.Marshal(openapi.NormalizeYAML(value))
+	if err != nil {
+		return err
+	}
+
+	parsed, err := openapi.Parse(specificationYAML)
+	if err != nil {
+		return err
+	}
+
+	openAPIYAML = b.Bytes()
+	openAPIJSON = j
+	openAPIDocument = parsed
+	return nil
+}
+
+// validateRequest は仕様書に記載されたパラメタの定義に従ってリクエストを検証する.
+// 仕様書の servers からの相対パスで操作を特定するため、 prefix にはルーティングのグループを指定する.
+func validateRequest(prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		operation, pathParams := openAPIDocument.FindOperation(c.Request.Method, strings.TrimPrefix(c.Request.URL.Path, prefix))
+		if operation == nil {
+			c.Next()
+			return
+		}
+
+		errs := openAPIDocument.ValidateRequest(operation, pathParams, c.Request.URL.Query())
+		if len(errs) == 0 {
+			c.Next()
+			return
+		}
+
+		invalidParams := make([]InvalidParam, 0, len(errs))
+		for _, err := range errs {
+			invalidParams = append(invalidParams, InvalidParam{
+				Name:    err.Name,
+				Reason:  err.Reason,
+				Rule:    err.Rule,
+				Value:   err.Value,
+				Allowed: err.Allowed,
+			})
+		}
+		respondProblem(c, 400, "invalid parameters", invalidParams...)
+	}
+}
+
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func getOpenAPIYAML(c *gin.Context) {
+	c.Data(200, "application/yaml; charset=utf-8", openAPIYAML)
+}
+
+func getOpenAPIJSON(c *gin.Context) {
+	c.Data(200, "application/json; charset=utf-8", openAPIJSON)
+}
+
+func getDocs(c *gin.Context) {
+	c.Data(200, "text/html; charset=utf-8", []byte(docsHTML))
+}
@@ -0,0 +1,83 @@
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+)
+
+// constraintError はスキーマの制約に違反したことを表す.
+// Rule は "minimum=0" のような違反したルール、 Reason は違反した理由.
+type constraintError struct {
+	Rule   string
+	Reason string
+}
+
+// checkConstraints は値が minimum 、 maximum 、 pattern 、 format の制約を満たすか検証する.
+// リクエストパラメタとレスポンスの検証で共通に用いる. 型の検証は呼び出し元で行い、数値は float64 、文字列は string の値のみ検証する.
+func (d *Document) checkConstraints(schema map[string]interface{}, value interface{}) []constraintError {
+	var errs []constraintError
+	switch v := value.(type) {
+	case float64:
+		if minimum, ok := number(schema["minimum"]); ok && v < minimum {
+			errs = append(errs, constraintError{fmt.Sprintf("minimum=%v", minimum), fmt.Sprintf("must be greater than or equal to %v", minimum)})
+		}
+		if maximum, ok := number(schema["maximum"]); ok && v > maximum {
+			errs = append(errs, constraintError{fmt.Sprintf("maximum=%v", maximum), fmt.Sprintf("must be less than or equal to %v", maximum)})
+		}
+		if str(schema["format"]) == "int32" && (v < math.MinInt32 || v > math.MaxInt32) {
+			errs = append(errs, constraintError{"format=int32", "must be a 32-bit integer"})
+		}
+	case string:
+		if pattern, ok := schema["pattern"].(string); ok && !d.pattern(pattern).MatchString(v) {
+			errs = append(errs, constraintError{"pattern=" + pattern, "must match pattern " + pattern})
+		}
+		if str(schema["format"]) == "date-time" {
+			if _, err := time.Parse(time.RFC3339, v); err != nil {
+				errs = append(errs, constraintError{"format=date-time", "must be RFC 3339 date-time"})
+			}
+		}
+	}
+	return errs
+}
+
+// pattern は仕様書の読み込み時にコンパイルした pattern を返す.
+// 仕様書に含まれないスキーマの pattern は、その都度コンパイルする.
+func (d *Document) pattern(pattern string) *regexp.Regexp {
+	if re, ok := d.patterns[pattern]; ok {
+		return re
+	}
+	return regexp.MustCompile(pattern)
+}
+
+// compilePatterns は仕様書に含まれるすべての pattern をコンパイルする. 正規表現として誤りのある pattern はエラーとする.
+// example の値は検証に用いないため対象としない.
+func compilePatterns(value interface{}, patterns map[string]*regexp.Regexp) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "example" || key == "examples" {
+				continue
+			}
+			if pattern, ok := child.(string); ok && key == "pattern" {
+				re, err := regexp.Compile(pattern)
+				if err != nil {
+					return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+				}
+				patterns[pattern] = re
+				continue
+			}
+			if err := compilePatterns(child, patterns); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if err := compilePatterns(child, patterns); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+// Package openapi は specification.yaml を読み込み、リクエストやレスポンスを検証するためのパッケージです.
+// P2P地震情報 API の仕様書で用いている範囲の OpenAPI 3.0 のみ扱います.
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Document struct {
+	root     map[string]interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+type Operation struct {
+	Method       string
+	PathTemplate string
+	Parameters   []Parameter
+	Responses    map[string]Response
+}
+
+type Parameter struct {
+	Name     string
+	In       string
+	Required bool
+	Schema   Schema
+}
+
+type Response struct {
+	Content map[string]Schema
+}
+
+type Schema map[string]interface{}
+
+func Parse(b []byte) (*Document, error) {
+	var value interface{}
+	if err := yaml.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	root, ok := NormalizeYAML(value).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid document")
+	}
+	patterns := map[string]*regexp.Regexp{}
+	if err := compilePatterns(root, patterns); err != nil {
+		return nil, err
+	}
+	return &Document{root: root, patterns: patterns}, nil
+}
+
+// NormalizeYAML はレスポンスコードなど文字列以外のキーを文字列に変換する.
+func NormalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			m[fmt.Sprint(key)] = NormalizeYAML(child)
+		}
+		return m
+	case map[string]interface{}:
+		for key, child := range v {
+			v[key] = NormalizeYAML(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = NormalizeYAML(child)
+		}
+	}
+	return value
+}
+
+// Operations は仕様書に記載されたすべての操作を返す.
+func (d *Document) Operations() []*Operation {
+	paths, _ := d.root["paths"].(map[string]interface{})
+	var templates []string
+	for template := range paths {
+		templates = append(templates, template)
+	}
+	sort.Strings(templates)
+
+	var operations []*Operation
+	for _, template := range templates {
+		item, _ := paths[template].(map[string]interface{})
+		for _, method := range []string{"get", "post", "put", "patch", "delete"} {
+			if _, ok := item[method].(map[string]interface{}); ok {
+				operations = append(operations, d.operation(template, method))
+			}
+		}
+	}
+	return operations
+}
+
+// FindOperation は servers からの相対パスに該当する操作とパスパラメタを返す.
+func (d *Document) FindOperation(method string, path string) (*Operation, map[string]string) {
+	paths, _ := d.root["paths"].(map[string]interface{})
+	method = strings.ToLower(method)
+
+	// 固定のパスをパスパラメタを含むパスより優先する.
+	if item, ok := paths[path].(map[string]interface{}); ok {
+		if _, ok := item[method].(map[string]interface{}); ok {
+			return d.operation(path, method), map[string]string{}
+		}
+	}
+
+	for template, item := range paths {
+		item, _ := item.(map[string]interface{})
+		if _, ok := item[method].(map[string]interface{}); !ok {
+			continue
+		}
+		if params, ok := matchPath(template, path); ok {
+			return d.operation(template, method), params
+		}
+	}
+	return nil, nil
+}
+
+func (d *Document) operation(template string, method string) *Operation {
+	paths, _ := d.root["paths"].(map[string]interface{})
+	item, _ := paths[template].(map[string]interface{})
+	op, _ := item[method].(map[string]interface{})
+
+	operation := &Operation{
+		Method:       strings.ToUpper(method),
+		PathTemplate: template,
+		Responses:    map[string]Response{},
+	}
+
+	// パスに記載されたパラメタを、操作に記載されたパラメタで上書きする.
+	parameters := map[string]Parameter{}
+	var order []string
+	for _, source := range []interface{}{item["parameters"], op["parameters"]} {
+		list, _ := source.([]interface{})
+		for _, p := range list {
+			m := d.resolve(p)
+			parameter := Parameter{
+				Name: str(m["name"]),
+				In:   str(m["in"]),
+			}
+			parameter.Required, _ = m["required"].(bool)
+			if schema, ok := m["schema"].(map[string]interface{}); ok {
+				parameter.Schema = schema
+			}
+			key := parameter.In + ":" + parameter.Name
+			if _, ok := parameters[key]; !ok {
+				order = append(order, key)
+			}
+			parameters[key] = parameter
+		}
+	}
+	for _, key := range order {
+		operation.Parameters = append(operation.Parameters, parameters[key])
+	}
+
+	responses, _ := op["responses"].(map[string]interface{})
+	for status, r := range responses {
+		m := d.resolve(r)
+		response := Response{Content: map[string]Schema{}}
+		content, _ := m["content"].(map[string]interface{})
+		for mediaType, c := range content {
+			c, _ := c.(map[string]interface{})
+			schema, _ := c["schema"].(map[string]interface{})
+			response.Content[mediaType] = schema
+		}
+		operation.Responses[status] = response
+	}
+
+	return operation
+}
+
+// Response はステータスコードに該当するレスポンスの定義を返す.
+func (o *Operation) Response(status int) (Response, bool) {
+	if r, ok := o.Responses[strconv.Itoa(status)]; ok {
+		return r, true
+	}
+	if r, ok := o.Responses[fmt.Sprintf("%dXX", status/100)]; ok {
+		return r, true
+	}
+	r, ok := o.Responses["default"]
+	return r, ok
+}
+
+// Schema はメディアタイプに該当するスキーマを返す.
+func (r Response) Schema(contentType string) (Schema, bool) {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	if schema, ok := r.Content[mediaType]; ok {
+		return schema, true
+	}
+	if strings.HasSuffix(mediaType, "+json") {
+		if schema, ok := r.Content["application/json"]; ok {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+// StatusText はレスポンス定義の一覧を表示用に整形する.
+func (o *Operation) StatusText() string {
+	var statuses []string
+	for status := range o.Responses {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	return strings.Join(statuses, ", ")
+}
+
+func (d *Document) resolve(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	for i := 0; i < 16; i++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		m = d.lookup(ref)
+	}
+	return m
+}
+
+func (d *Document) lookup(ref string) map[string]interface{} {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil
+	}
+	var current interface{} = d.root
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[token]
+	}
+	m, _ := current.(map[string]interface{})
+	return m
+}
+
+func matchPath(template string, path string) (map[string]string, bool) {
+	templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range templateSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			params[segment[1:len(segment)-1]] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func str(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
@@ -0,0 +1,265 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testSpec は仕様書で用いている構文を一通り含む、テスト用の仕様書.
+const testSpec = `
+openapi: 3.0.0
+paths:
+  /items:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/limit'
+        - name: kind
+          in: query
+          schema:
+            type: string
+            enum: [a, b]
+        - name: codes
+          in: query
+          schema:
+            type: array
+            items:
+              type: integer
+              enum: [551, 552]
+        - name: flag
+          in: query
+          schema:
+            type: boolean
+        - name: ratio
+          in: query
+          schema:
+            type: number
+            minimum: 0.1
+            maximum: 1
+        - name: since
+          in: query
+          schema:
+            type: string
+            format: date-time
+        - name: count
+          in: query
+          schema:
+            type: integer
+            format: int32
+      responses:
+        200:
+          description: ok
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Item'
+        4XX:
+          $ref: '#/components/responses/Problem'
+  /items/latest:
+    get:
+      responses:
+        200:
+          description: ok
+  /items/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        schema:
+          type: string
+          pattern: ^[0-9a-f]{4}$
+    get:
+      responses:
+        200:
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Item'
+        default:
+          $ref: '#/components/responses/Problem'
+  /items/{id}/children:
+    get:
+      responses:
+        200:
+          description: ok
+components:
+  parameters:
+    limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+        minimum: 0
+        maximum: 100
+  responses:
+    Problem:
+      description: problem
+      content:
+        application/problem+json:
+          schema:
+            $ref: '#/components/schemas/Problem'
+  schemas:
+    Problem:
+      type: object
+      required: [status]
+      properties:
+        status:
+          type: integer
+    Item:
+      type: object
+      required: [id, code]
+      properties:
+        id:
+          type: string
+        code:
+          type: integer
+          enum: [551, 552]
+        note:
+          type: string
+          nullable: true
+        created_at:
+          type: string
+          format: date-time
+        key:
+          type: string
+          pattern: ^[a-z]+$
+        tags:
+          type: object
+          additionalProperties:
+            type: integer
+`
+
+func parseTestSpec(t *testing.T) *Document {
+	t.Helper()
+	document, err := Parse([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return document
+}
+
+func TestParseInvalid(t *testing.T) {
+	if _, err := Parse([]byte("- a\n- b\n")); err == nil {
+		t.Error("Parse() of a list succeeded, want error")
+	}
+	if _, err := Parse([]byte("a: [")); err == nil {
+		t.Error("Parse() of broken YAML succeeded, want error")
+	}
+}
+
+func TestFindOperation(t *testing.T) {
+	document := parseTestSpec(t)
+
+	tests := []struct {
+		method       string
+		path         string
+		wantTemplate string
+		wantParams   map[string]string
+	}{
+		{"GET", "/items", "/items", map[string]string{}},
+		{"get", "/items", "/items", map[string]string{}},
+		{"GET", "/items/latest", "/items/latest", map[string]string{}},
+		{"GET", "/items/00ff", "/items/{id}", map[string]string{"id": "00ff"}},
+		{"GET", "/items/00ff/children", "/items/{id}/children", map[string]string{"id": "00ff"}},
+		{"GET", "/items/00ff/unknown", "", nil},
+		{"GET", "/unknown", "", nil},
+		{"POST", "/items", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			operation, params := document.FindOperation(tt.method, tt.path)
+			if tt.wantTemplate == "" {
+				if operation != nil {
+					t.Errorf("FindOperation() = %s, want nil", operation.PathTemplate)
+				}
+				return
+			}
+			if operation == nil {
+				t.Fatalf("FindOperation() = nil, want %s", tt.wantTemplate)
+			}
+			if operation.PathTemplate != tt.wantTemplate || operation.Method != "GET" {
+				t.Errorf("FindOperation() = %s %s, want GET %s", operation.Method, operation.PathTemplate, tt.wantTemplate)
+			}
+			if !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("path params = %v, want %v", params, tt.wantParams)
+			}
+		})
+	}
+}
+
+func TestOperationParameters(t *testing.T) {
+	document := parseTestSpec(t)
+
+	operation, _ := document.FindOperation("GET", "/items")
+	var names []string
+	for _, parameter := range operation.Parameters {
+		names = append(names, parameter.In+":"+parameter.Name)
+	}
+	want := []string{"query:limit", "query:kind", "query:codes", "query:flag", "query:ratio", "query:since", "query:count"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("parameters = %v, want %v", names, want)
+	}
+
+	// パスに記載されたパラメタも操作のパラメタに含める.
+	operation, _ = document.FindOperation("GET", "/items/00ff")
+	if len(operation.Parameters) != 1 || operation.Parameters[0].Name != "id" || !operation.Parameters[0].Required {
+		t.Errorf("parameters = %+v, want required path parameter id", operation.Parameters)
+	}
+}
+
+func TestOperationResponse(t *testing.T) {
+	document := parseTestSpec(t)
+	items, _ := document.FindOperation("GET", "/items")
+	item, _ := document.FindOperation("GET", "/items/00ff")
+
+	tests := []struct {
+		name        string
+		operation   *Operation
+		status      int
+		contentType string
+		wantFound   bool
+		wantSchema  bool
+	}{
+		{"exact status", items, 200, "application/json; charset=utf-8", true, true},
+		{"status range", items, 404, "application/problem+json", true, true},
+		{"undocumented status", items, 500, "application/json", false, false},
+		{"default response", item, 500, "application/problem+json", true, true},
+		{"undocumented media type", items, 200, "text/plain", true, false},
+		{"json suffix falls back to application/json", item, 200, "application/vnd.p2pquake+json", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, ok := tt.operation.Response(tt.status)
+			if ok != tt.wantFound {
+				t.Fatalf("Response(%d) found = %v, want %v", tt.status, ok, tt.wantFound)
+			}
+			if !ok {
+				return
+			}
+			if _, ok := response.Schema(tt.contentType); ok != tt.wantSchema {
+				t.Errorf("Schema(%q) found = %v, want %v", tt.contentType, ok, tt.wantSchema)
+			}
+		})
+	}
+
+	if got := items.StatusText(); got != "200, 4XX" {
+		t.Errorf("StatusText() = %q, want %q", got, "200, 4XX")
+	}
+}
+
+func TestOperations(t *testing.T) {
+	document := parseTestSpec(t)
+
+	var templates []string
+	for _, operation := range document.Operations() {
+		templates = append(templates, operation.Method+" "+operation.PathTemplate)
+	}
+	want := []string{"GET /items", "GET /items/latest", "GET /items/{id}", "GET /items/{id}/children"}
+	if !reflect.DeepEqual(templates, want) {
+		t.Errorf("Operations() = %v, want %v", templates, want)
+	}
+}
@@ -0,0 +1,115 @@
+package openapi
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// ParameterError はリクエストパラメタの検証に失敗したパラメタと、違反したルールを表す.
+type ParameterError struct {
+	Name    string
+	In      string
+	Value   string
+	Rule    string
+	Reason  string
+	Allowed []interface{}
+}
+
+func (e ParameterError) Error() string {
+	return e.In + " parameter " + e.Name + ": " + e.Reason
+}
+
+// ValidateRequest はパスパラメタとクエリパラメタが操作の定義に適合するか検証する.
+// 仕様書に記載のないクエリパラメタはエラーとする.
+func (d *Document) ValidateRequest(operation *Operation, pathParams map[string]string, query url.Values) []ParameterError {
+	var errs []ParameterError
+	known := map[string]bool{}
+
+	for _, parameter := range operation.Parameters {
+		var values []string
+		switch parameter.In {
+		case "path":
+			if value, ok := pathParams[parameter.Name]; ok {
+				values = []string{value}
+			}
+		case "query":
+			known[parameter.Name] = true
+			values = query[parameter.Name]
+		default:
+			continue
+		}
+
+		if len(values) == 0 {
+			if parameter.Required {
+				errs = append(errs, ParameterError{Name: parameter.Name, In: parameter.In, Rule: "required", Reason: "is required"})
+			}
+			continue
+		}
+
+		schema := d.resolve(map[string]interface{}(parameter.Schema))
+		if str(schema["type"]) == "array" {
+			schema = d.resolve(schema["items"])
+		} else if len(values) > 1 {
+			errs = append(errs, ParameterError{Name: parameter.Name, In: parameter.In, Rule: "single", Reason: "must not be repeated"})
+			continue
+		}
+
+		for _, value := range values {
+			if err, ok := d.validateParameter(schema, value); !ok {
+				err.Name = parameter.Name
+				err.In = parameter.In
+				err.Value = value
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	var unknown []string
+	for name := range query {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs = append(errs, ParameterError{Name: name, In: "query", Rule: "unknown", Reason: "unknown parameter"})
+	}
+
+	return errs
+}
+
+// validateParameter は文字列で受け取ったパラメタの値をスキーマの型に変換して検証する.
+func (d *Document) validateParameter(schema map[string]interface{}, raw string) (ParameterError, bool) {
+	var value interface{} = raw
+	switch t := str(schema["type"]); t {
+	case "integer":
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return ParameterError{Rule: "type=" + t, Reason: "must be integer"}, false
+		}
+		value = float64(i)
+	case "number":
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return ParameterError{Rule: "type=" + t, Reason: "must be number"}, false
+		}
+		value = f
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return ParameterError{Rule: "type=" + t, Reason: "must be boolean"}, false
+		}
+		value = b
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		return ParameterError{Rule: "enum", Reason: "must be one of the allowed values", Allowed: enum}, false
+	}
+
+	if errs := d.checkConstraints(schema, value); len(errs) > 0 {
+		return ParameterError{Rule: errs[0].Rule, Reason: errs[0].Reason}, false
+	}
+
+	return ParameterError{}, true
+}
@@ -0,0 +1,90 @@
+package openapi
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateRequest(t *testing.T) {
+	document := parseTestSpec(t)
+
+	tests := []struct {
+		name  string
+		path  string
+		query string
+		want  []string
+	}{
+		{"no parameters", "/items", "", nil},
+		{"valid parameters", "/items", "limit=100&kind=a&codes=551&codes=552&flag=true&ratio=0.5", nil},
+		{"integer below minimum", "/items", "limit=-1", []string{"limit:minimum=0"}},
+		{"integer above maximum", "/items", "limit=101", []string{"limit:maximum=100"}},
+		{"not an integer", "/items", "limit=1.5", []string{"limit:type=integer"}},
+		{"not a number", "/items", "ratio=x", []string{"ratio:type=number"}},
+		{"number below minimum", "/items", "ratio=0.05", []string{"ratio:minimum=0.1"}},
+		{"not a boolean", "/items", "flag=yes", []string{"flag:type=boolean"}},
+		{"enum", "/items", "kind=c", []string{"kind:enum"}},
+		{"array items are validated one by one", "/items", "codes=551&codes=553", []string{"codes:enum"}},
+		{"repeated scalar", "/items", "kind=a&kind=b", []string{"kind:single"}},
+		{"unknown parameters are sorted", "/items", "z=1&a=1", []string{"a:unknown", "z:unknown"}},
+		{"path pattern", "/items/zzzz", "", []string{"id:pattern=^[0-9a-f]{4}$"}},
+		{"valid path", "/items/00ff", "", nil},
+		{"date-time", "/items", "since=2021-05-28T21:00:00%2B09:00", nil},
+		{"not a date-time", "/items", "since=2021-05-28", []string{"since:format=date-time"}},
+		{"int32", "/items", "count=2147483647", nil},
+		{"not an int32", "/items", "count=2147483648", []string{"count:format=int32"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation, pathParams := document.FindOperation("GET", tt.path)
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, err := range document.ValidateRequest(operation, pathParams, query) {
+				got = append(got, err.Name+":"+err.Rule)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRequestRequired(t *testing.T) {
+	document := parseTestSpec(t)
+	operation, _ := document.FindOperation("GET", "/items/00ff")
+
+	errs := document.ValidateRequest(operation, map[string]string{}, url.Values{})
+	if len(errs) != 1 || errs[0].Rule != "required" || errs[0].In != "path" {
+		t.Errorf("ValidateRequest() = %v, want required path parameter error", errs)
+	}
+}
+
+func TestParameterError(t *testing.T) {
+	document := parseTestSpec(t)
+	operation, pathParams := document.FindOperation("GET", "/items")
+
+	errs := document.ValidateRequest(operation, pathParams, url.Values{"kind": {"c"}})
+	if len(errs) != 1 {
+		t.Fatalf("ValidateRequest() = %v, want one error", errs)
+	}
+	err := errs[0]
+	if err.Value != "c" || err.In != "query" || !reflect.DeepEqual(err.Allowed, []interface{}{"a", "b"}) {
+		t.Errorf("error = %+v, want value c and allowed [a b]", err)
+	}
+	if got := err.Error(); got != "query parameter kind: must be one of the allowed values" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestParseInvalidPattern(t *testing.T) {
+	spec := strings.Replace(testSpec, "pattern: ^[0-9a-f]{4}$", "pattern: ^([0-9a-f]{4}$", 1)
+	if _, err := Parse([]byte(spec)); err == nil || !strings.Contains(err.Error(), "invalid pattern") {
+		t.Errorf("Parse() error = %v, want invalid pattern error", err)
+	}
+}
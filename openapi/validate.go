@@ -0,0 +1,304 @@
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidationError は検証に失敗した箇所と理由を表す.
+type ValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Reason
+	}
+	return e.Path + ": " + e.Reason
+}
+
+// Validate は JSON をデコードした値がスキーマに適合するか検証する.
+// strict が true の場合、 additionalProperties が指定されていないオブジェクトに
+// スキーマに記載のないプロパティが含まれていればエラーとする.
+func (d *Document) Validate(schema Schema, value interface{}, strict bool) []ValidationError {
+	// allOf の各スキーマと、それらを合わせたスキーマの両方で検証するため重複を取り除く.
+	var errs []ValidationError
+	seen := map[ValidationError]bool{}
+	for _, err := range d.validate(schema, value, "", strict) {
+		if !seen[err] {
+			seen[err] = true
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (d *Document) validate(schema map[string]interface{}, value interface{}, path string, strict bool) []ValidationError {
+	schema = d.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if value == nil {
+		if nullable, _ := schema["nullable"].(bool); nullable {
+			return nil
+		}
+		if _, ok := schema["type"]; ok {
+			return []ValidationError{{path, "null is not allowed"}}
+		}
+	}
+
+	var errs []ValidationError
+
+	for _, sub := range list(schema["allOf"]) {
+		errs = append(errs, d.validate(sub, value, path, false)...)
+	}
+	if anyOf := list(schema["anyOf"]); len(anyOf) > 0 {
+		errs = append(errs, d.matchAny(anyOf, value, path, strict)...)
+	}
+	if oneOf := list(schema["oneOf"]); len(oneOf) > 0 {
+		errs = append(errs, d.matchAny(oneOf, value, path, strict)...)
+	}
+
+	if t, ok := schema["type"].(string); ok && !matchType(t, value) {
+		return append(errs, ValidationError{path, fmt.Sprintf("expected %s, got %s", t, typeName(value))})
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, value) {
+		errs = append(errs, ValidationError{path, fmt.Sprintf("%v is not one of %v", value, enum)})
+	}
+
+	for _, err := range d.checkConstraints(schema, value) {
+		errs = append(errs, ValidationError{path, fmt.Sprintf("%s %s", formatValue(value), err.Reason)})
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				errs = append(errs, d.validate(items, item, fmt.Sprintf("%s[%d]", path, i), strict)...)
+			}
+		}
+	case map[string]interface{}:
+		errs = append(errs, d.validateObject(schema, v, path, strict)...)
+	}
+
+	return errs
+}
+
+func (d *Document) validateObject(schema map[string]interface{}, value map[string]interface{}, path string, strict bool) []ValidationError {
+	var errs []ValidationError
+
+	properties, additional, open := d.collectProperties(schema)
+
+	for _, name := range d.collectRequired(schema) {
+		if _, ok := value[name]; !ok {
+			errs = append(errs, ValidationError{join(path, name), "required property is missing"})
+		}
+	}
+
+	var keys []string
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		child := value[key]
+		if property, ok := properties[key]; ok {
+			errs = append(errs, d.validate(property, child, join(path, key), strict)...)
+			continue
+		}
+		if additional != nil {
+			errs = append(errs, d.validate(additional, child, join(path, key), strict)...)
+			continue
+		}
+		if strict && !open {
+			errs = append(errs, ValidationError{join(path, key), "property is not documented"})
+		}
+	}
+
+	return errs
+}
+
+// collectProperties は allOf を含めてスキーマに記載されたプロパティを集める.
+// open は anyOf や additionalProperties: true などにより未記載のプロパティを許容するかどうか.
+func (d *Document) collectProperties(schema map[string]interface{}) (map[string]map[string]interface{}, map[string]interface{}, bool) {
+	schema = d.resolve(schema)
+	properties := map[string]map[string]interface{}{}
+	var additional map[string]interface{}
+	open := false
+
+	if p, ok := schema["properties"].(map[string]interface{}); ok {
+		for name, property := range p {
+			property, _ := property.(map[string]interface{})
+			if existing, ok := properties[name]; ok {
+				property = map[string]interface{}{"allOf": []interface{}{existing, property}}
+			}
+			properties[name] = property
+		}
+	}
+	switch a := schema["additionalProperties"].(type) {
+	case bool:
+		open = open || a
+	case map[string]interface{}:
+		additional = a
+	}
+	if _, ok := schema["anyOf"]; ok {
+		open = true
+	}
+	if _, ok := schema["oneOf"]; ok {
+		open = true
+	}
+
+	for _, sub := range list(schema["allOf"]) {
+		p, a, o := d.collectProperties(sub)
+		for name, property := range p {
+			if existing, ok := properties[name]; ok {
+				property = map[string]interface{}{"allOf": []interface{}{existing, property}}
+			}
+			properties[name] = property
+		}
+		if a != nil {
+			additional = a
+		}
+		open = open || o
+	}
+
+	return properties, additional, open
+}
+
+func (d *Document) collectRequired(schema map[string]interface{}) []string {
+	schema = d.resolve(schema)
+	var required []string
+	for _, name := range listValues(schema["required"]) {
+		if s, ok := name.(string); ok {
+			required = append(required, s)
+		}
+	}
+	for _, sub := range list(schema["allOf"]) {
+		required = append(required, d.collectRequired(sub)...)
+	}
+	return required
+}
+
+// matchAny はいずれかのスキーマに適合すれば nil を、そうでなければ最も近いスキーマでのエラーを返す.
+func (d *Document) matchAny(schemas []map[string]interface{}, value interface{}, path string, strict bool) []ValidationError {
+	var closest []ValidationError
+	for _, sub := range schemas {
+		errs := d.validate(sub, value, path, strict)
+		if len(errs) == 0 {
+			return nil
+		}
+		if closest == nil || len(errs) < len(closest) {
+			closest = errs
+		}
+	}
+	return closest
+}
+
+func matchType(t string, value interface{}) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		f, ok := value.(float64)
+		return ok && f == math.Trunc(f)
+	}
+	return true
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// formatValue はエラーの理由に含める値を整形する. 文字列は引用符で囲む.
+func formatValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(value)
+}
+
+func containsValue(enum []interface{}, value interface{}) bool {
+	for _, candidate := range enum {
+		if c, ok := number(candidate); ok {
+			if v, ok := value.(float64); ok && c == v {
+				return true
+			}
+			continue
+		}
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func number(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func list(value interface{}) []map[string]interface{} {
+	var schemas []map[string]interface{}
+	for _, item := range listValues(value) {
+		if m, ok := item.(map[string]interface{}); ok {
+			schemas = append(schemas, m)
+		}
+	}
+	return schemas
+}
+
+func listValues(value interface{}) []interface{} {
+	l, _ := value.([]interface{})
+	return l
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	if strings.ContainsAny(key, ".[]") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	return path + "." + key
+}
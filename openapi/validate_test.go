@@ -0,0 +1,102 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	document := parseTestSpec(t)
+	items, _ := document.FindOperation("GET", "/items")
+	response, _ := items.Response(200)
+	schema, _ := response.Schema("application/json")
+
+	tests := []struct {
+		name   string
+		body   string
+		strict bool
+		want   []string
+	}{
+		{"valid", `[{"id":"a","code":551,"note":null,"tags":{"x":1}}]`, true, nil},
+		{"empty array", `[]`, true, nil},
+		{"not an array", `{}`, true, []string{"expected array, got object"}},
+		{"missing required", `[{"id":"a"}]`, true, []string{"[0].code: required property is missing"}},
+		{"wrong type", `[{"id":1,"code":551}]`, true, []string{"[0].id: expected string, got number"}},
+		{"integer with fraction", `[{"id":"a","code":551.5}]`, true, []string{"[0].code: expected integer, got number"}},
+		{"enum", `[{"id":"a","code":553}]`, true, []string{"[0].code: 553 is not one of [551 552]"}},
+		{"null without nullable", `[{"id":null,"code":551}]`, true, []string{"[0].id: null is not allowed"}},
+		{"undocumented property in strict mode", `[{"id":"a","code":551,"extra":1}]`, true, []string{"[0].extra: property is not documented"}},
+		{"undocumented property in loose mode", `[{"id":"a","code":551,"extra":1}]`, false, nil},
+		{"date-time", `[{"id":"a","code":551,"created_at":"2021-05-28T21:00:00+09:00"}]`, true, nil},
+		{"not a date-time", `[{"id":"a","code":551,"created_at":"2021/05/28 21:00:00"}]`, true, []string{`[0].created_at: "2021/05/28 21:00:00" must be RFC 3339 date-time`}},
+		{"pattern", `[{"id":"a","code":551,"key":"A"}]`, true, []string{`[0].key: "A" must match pattern ^[a-z]+$`}},
+		{"additionalProperties schema", `[{"id":"a","code":551,"tags":{"x":"1"}}]`, true, []string{`[0].tags.x: expected integer, got string`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.body), &value); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, err := range document.Validate(schema, value, tt.strict) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateComposition(t *testing.T) {
+	document := parseTestSpec(t)
+
+	allOf := Schema{"allOf": []interface{}{
+		map[string]interface{}{"$ref": "#/components/schemas/Item"},
+		map[string]interface{}{"type": "object", "properties": map[string]interface{}{"extra": map[string]interface{}{"type": "boolean"}}},
+	}}
+	oneOf := Schema{"oneOf": []interface{}{
+		map[string]interface{}{"type": "string"},
+		map[string]interface{}{"type": "integer", "minimum": 10},
+	}}
+
+	tests := []struct {
+		name   string
+		schema Schema
+		body   string
+		want   []string
+	}{
+		{"allOf merges properties", allOf, `{"id":"a","code":551,"extra":true}`, nil},
+		{"allOf keeps required", allOf, `{"id":"a","extra":true}`, []string{"code: required property is missing"}},
+		{"allOf rejects undocumented", allOf, `{"id":"a","code":551,"other":1}`, []string{"other: property is not documented"}},
+		{"oneOf matches first", oneOf, `"a"`, nil},
+		{"oneOf matches second", oneOf, `12`, nil},
+		{"minimum", Schema{"type": "integer", "minimum": 10}, `5`, []string{"5 must be greater than or equal to 10"}},
+		{"oneOf reports the first of equally close schemas", oneOf, `5`, []string{"expected string, got number"}},
+		{"oneOf reports closest", Schema{"oneOf": []interface{}{
+			map[string]interface{}{"$ref": "#/components/schemas/Item"},
+			map[string]interface{}{"type": "integer", "minimum": 10},
+		}}, `{"id":"a"}`, []string{"code: required property is missing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.body), &value); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, err := range document.Validate(tt.schema, value, true) {
+				got = append(got, err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
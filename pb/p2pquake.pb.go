@@ -0,0 +1,5759 @@
+// P2P地震情報 API のレスポンスを Protocol Buffers で表現したものです.
+// 各メッセージは specification.yaml のスキーマに対応します.
+// Accept: application/x-protobuf で要求した場合、 Content-Type の messageType パラメタに
+// レスポンスのメッセージ名が含まれます.
+// lang=en で付加する英語表記は、末尾が _en のフィールドに含まれます.
+// 対応するメッセージのないレスポンス (/v1/human-readable) は google.protobuf.Value として返却します.
+// /areas.geojson などの固有の形式 (GeoJSON など) で返却するエンドポイントは、 Accept ヘッダによらずその形式で返却します.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: p2pquake.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 地震情報 (code: 551)
+type JMAQuake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code       int32                `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time       string               `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Issue      *JMAQuake_Issue      `protobuf:"bytes,4,opt,name=issue,proto3" json:"issue,omitempty"`
+	Earthquake *JMAQuake_Earthquake `protobuf:"bytes,5,opt,name=earthquake,proto3" json:"earthquake,omitempty"`
+	Points     []*JMAQuake_Point    `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *JMAQuake) Reset() {
+	*x = JMAQuake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuake) ProtoMessage() {}
+
+func (x *JMAQuake) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuake.ProtoReflect.Descriptor instead.
+func (*JMAQuake) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JMAQuake) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JMAQuake) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *JMAQuake) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JMAQuake) GetIssue() *JMAQuake_Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *JMAQuake) GetEarthquake() *JMAQuake_Earthquake {
+	if x != nil {
+		return x.Earthquake
+	}
+	return nil
+}
+
+func (x *JMAQuake) GetPoints() []*JMAQuake_Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type JMAQuakes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*JMAQuake `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *JMAQuakes) Reset() {
+	*x = JMAQuakes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuakes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuakes) ProtoMessage() {}
+
+func (x *JMAQuakes) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuakes.ProtoReflect.Descriptor instead.
+func (*JMAQuakes) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JMAQuakes) GetItems() []*JMAQuake {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 津波予報 (code: 552)
+type JMATsunami struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code      int32              `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time      string             `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Cancelled bool               `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Issue     *JMATsunami_Issue  `protobuf:"bytes,5,opt,name=issue,proto3" json:"issue,omitempty"`
+	Areas     []*JMATsunami_Area `protobuf:"bytes,6,rep,name=areas,proto3" json:"areas,omitempty"`
+}
+
+func (x *JMATsunami) Reset() {
+	*x = JMATsunami{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMATsunami) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMATsunami) ProtoMessage() {}
+
+func (x *JMATsunami) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMATsunami.ProtoReflect.Descriptor instead.
+func (*JMATsunami) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JMATsunami) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JMATsunami) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *JMATsunami) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JMATsunami) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *JMATsunami) GetIssue() *JMATsunami_Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *JMATsunami) GetAreas() []*JMATsunami_Area {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+type JMATsunamis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*JMATsunami `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *JMATsunamis) Reset() {
+	*x = JMATsunamis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMATsunamis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMATsunamis) ProtoMessage() {}
+
+func (x *JMATsunamis) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMATsunamis.ProtoReflect.Descriptor instead.
+func (*JMATsunamis) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JMATsunamis) GetItems() []*JMATsunami {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 緊急地震速報 発表検出 (code: 554)
+type EEWDetection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *EEWDetection) Reset() {
+	*x = EEWDetection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EEWDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EEWDetection) ProtoMessage() {}
+
+func (x *EEWDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EEWDetection.ProtoReflect.Descriptor instead.
+func (*EEWDetection) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EEWDetection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EEWDetection) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EEWDetection) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *EEWDetection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// 各地域ピア数 (code: 555)
+type Areapeers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  int32             `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time  string            `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Areas []*Areapeers_Area `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
+}
+
+func (x *Areapeers) Reset() {
+	*x = Areapeers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Areapeers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Areapeers) ProtoMessage() {}
+
+func (x *Areapeers) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Areapeers.ProtoReflect.Descriptor instead.
+func (*Areapeers) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Areapeers) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Areapeers) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Areapeers) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Areapeers) GetAreas() []*Areapeers_Area {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+// 地震感知情報 (code: 561)
+type Userquake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code   int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time   string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Area   int32  `protobuf:"varint,4,opt,name=area,proto3" json:"area,omitempty"`
+	AreaEn string `protobuf:"bytes,5,opt,name=area_en,json=areaEn,proto3" json:"area_en,omitempty"`
+}
+
+func (x *Userquake) Reset() {
+	*x = Userquake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Userquake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Userquake) ProtoMessage() {}
+
+func (x *Userquake) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Userquake.ProtoReflect.Descriptor instead.
+func (*Userquake) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Userquake) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Userquake) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Userquake) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Userquake) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *Userquake) GetAreaEn() string {
+	if x != nil {
+		return x.AreaEn
+	}
+	return ""
+}
+
+type Userquakes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Userquake `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Userquakes) Reset() {
+	*x = Userquakes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Userquakes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Userquakes) ProtoMessage() {}
+
+func (x *Userquakes) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Userquakes.ProtoReflect.Descriptor instead.
+func (*Userquakes) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Userquakes) GetItems() []*Userquake {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 地震感知情報 解析結果 (code: 9611)
+type UserquakeEvaluation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code            int32                                          `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time            string                                         `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Count           int32                                          `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Confidence      float64                                        `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	StartedAt       string                                         `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt       string                                         `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AreaConfidences map[string]*UserquakeEvaluation_AreaConfidence `protobuf:"bytes,8,rep,name=area_confidences,json=areaConfidences,proto3" json:"area_confidences,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserquakeEvaluation) Reset() {
+	*x = UserquakeEvaluation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvaluation) ProtoMessage() {}
+
+func (x *UserquakeEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvaluation.ProtoReflect.Descriptor instead.
+func (*UserquakeEvaluation) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserquakeEvaluation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserquakeEvaluation) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UserquakeEvaluation) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *UserquakeEvaluation) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserquakeEvaluation) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *UserquakeEvaluation) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *UserquakeEvaluation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *UserquakeEvaluation) GetAreaConfidences() map[string]*UserquakeEvaluation_AreaConfidence {
+	if x != nil {
+		return x.AreaConfidences
+	}
+	return nil
+}
+
+type UserquakeEvaluations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*UserquakeEvaluation `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *UserquakeEvaluations) Reset() {
+	*x = UserquakeEvaluations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvaluations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvaluations) ProtoMessage() {}
+
+func (x *UserquakeEvaluations) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvaluations.ProtoReflect.Descriptor instead.
+func (*UserquakeEvaluations) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UserquakeEvaluations) GetItems() []*UserquakeEvaluation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /history のレスポンス. 情報コードに応じていずれかのフィールドが設定されます.
+type HistoryRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Record:
+	//	*HistoryRecord_JmaQuake
+	//	*HistoryRecord_JmaTsunami
+	//	*HistoryRecord_EewDetection
+	//	*HistoryRecord_Areapeers
+	//	*HistoryRecord_Userquake
+	//	*HistoryRecord_UserquakeEvaluation
+	//	*HistoryRecord_Other
+	Record isHistoryRecord_Record `protobuf_oneof:"record"`
+}
+
+func (x *HistoryRecord) Reset() {
+	*x = HistoryRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRecord) ProtoMessage() {}
+
+func (x *HistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRecord.ProtoReflect.Descriptor instead.
+func (*HistoryRecord) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *HistoryRecord) GetRecord() isHistoryRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetJmaQuake() *JMAQuake {
+	if x, ok := x.GetRecord().(*HistoryRecord_JmaQuake); ok {
+		return x.JmaQuake
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetJmaTsunami() *JMATsunami {
+	if x, ok := x.GetRecord().(*HistoryRecord_JmaTsunami); ok {
+		return x.JmaTsunami
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetEewDetection() *EEWDetection {
+	if x, ok := x.GetRecord().(*HistoryRecord_EewDetection); ok {
+		return x.EewDetection
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetAreapeers() *Areapeers {
+	if x, ok := x.GetRecord().(*HistoryRecord_Areapeers); ok {
+		return x.Areapeers
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetUserquake() *Userquake {
+	if x, ok := x.GetRecord().(*HistoryRecord_Userquake); ok {
+		return x.Userquake
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetUserquakeEvaluation() *UserquakeEvaluation {
+	if x, ok := x.GetRecord().(*HistoryRecord_UserquakeEvaluation); ok {
+		return x.UserquakeEvaluation
+	}
+	return nil
+}
+
+func (x *HistoryRecord) GetOther() *structpb.Struct {
+	if x, ok := x.GetRecord().(*HistoryRecord_Other); ok {
+		return x.Other
+	}
+	return nil
+}
+
+type isHistoryRecord_Record interface {
+	isHistoryRecord_Record()
+}
+
+type HistoryRecord_JmaQuake struct {
+	JmaQuake *JMAQuake `protobuf:"bytes,1,opt,name=jma_quake,json=jmaQuake,proto3,oneof"`
+}
+
+type HistoryRecord_JmaTsunami struct {
+	JmaTsunami *JMATsunami `protobuf:"bytes,2,opt,name=jma_tsunami,json=jmaTsunami,proto3,oneof"`
+}
+
+type HistoryRecord_EewDetection struct {
+	EewDetection *EEWDetection `protobuf:"bytes,3,opt,name=eew_detection,json=eewDetection,proto3,oneof"`
+}
+
+type HistoryRecord_Areapeers struct {
+	Areapeers *Areapeers `protobuf:"bytes,4,opt,name=areapeers,proto3,oneof"`
+}
+
+type HistoryRecord_Userquake struct {
+	Userquake *Userquake `protobuf:"bytes,5,opt,name=userquake,proto3,oneof"`
+}
+
+type HistoryRecord_UserquakeEvaluation struct {
+	UserquakeEvaluation *UserquakeEvaluation `protobuf:"bytes,6,opt,name=userquake_evaluation,json=userquakeEvaluation,proto3,oneof"`
+}
+
+type HistoryRecord_Other struct {
+	Other *structpb.Struct `protobuf:"bytes,15,opt,name=other,proto3,oneof"`
+}
+
+func (*HistoryRecord_JmaQuake) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_JmaTsunami) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_EewDetection) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_Areapeers) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_Userquake) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_UserquakeEvaluation) isHistoryRecord_Record() {}
+
+func (*HistoryRecord_Other) isHistoryRecord_Record() {}
+
+type History struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*HistoryRecord `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *History) Reset() {
+	*x = History{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *History) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*History) ProtoMessage() {}
+
+func (x *History) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use History.ProtoReflect.Descriptor instead.
+func (*History) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *History) GetItems() []*HistoryRecord {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /areas の地域
+type Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Region       string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Prefecture   string `protobuf:"bytes,3,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	Name         string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	RegionEn     string `protobuf:"bytes,5,opt,name=region_en,json=regionEn,proto3" json:"region_en,omitempty"`
+	PrefectureEn string `protobuf:"bytes,6,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	NameEn       string `protobuf:"bytes,7,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+}
+
+func (x *Area) Reset() {
+	*x = Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Area) ProtoMessage() {}
+
+func (x *Area) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Area.ProtoReflect.Descriptor instead.
+func (*Area) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Area) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Area) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Area) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Area) GetRegionEn() string {
+	if x != nil {
+		return x.RegionEn
+	}
+	return ""
+}
+
+func (x *Area) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *Area) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+type Areas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Area `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Areas) Reset() {
+	*x = Areas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Areas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Areas) ProtoMessage() {}
+
+func (x *Areas) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Areas.ProtoReflect.Descriptor instead.
+func (*Areas) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Areas) GetItems() []*Area {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /areapeers の各地域ピア数
+type AreapeersSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code      int32                    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time      string                   `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Total     int32                    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Areas     []*AreapeersSummary_Area `protobuf:"bytes,5,rep,name=areas,proto3" json:"areas,omitempty"`
+	Regions   map[string]int32         `protobuf:"bytes,6,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Prefs     map[string]int32         `protobuf:"bytes,7,rep,name=prefs,proto3" json:"prefs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RegionsEn map[string]int32         `protobuf:"bytes,8,rep,name=regions_en,json=regionsEn,proto3" json:"regions_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PrefsEn   map[string]int32         `protobuf:"bytes,9,rep,name=prefs_en,json=prefsEn,proto3" json:"prefs_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *AreapeersSummary) Reset() {
+	*x = AreapeersSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AreapeersSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreapeersSummary) ProtoMessage() {}
+
+func (x *AreapeersSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreapeersSummary.ProtoReflect.Descriptor instead.
+func (*AreapeersSummary) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AreapeersSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AreapeersSummary) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AreapeersSummary) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AreapeersSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AreapeersSummary) GetAreas() []*AreapeersSummary_Area {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *AreapeersSummary) GetRegions() map[string]int32 {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *AreapeersSummary) GetPrefs() map[string]int32 {
+	if x != nil {
+		return x.Prefs
+	}
+	return nil
+}
+
+func (x *AreapeersSummary) GetRegionsEn() map[string]int32 {
+	if x != nil {
+		return x.RegionsEn
+	}
+	return nil
+}
+
+func (x *AreapeersSummary) GetPrefsEn() map[string]int32 {
+	if x != nil {
+		return x.PrefsEn
+	}
+	return nil
+}
+
+type AreapeersSummaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AreapeersSummary `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AreapeersSummaries) Reset() {
+	*x = AreapeersSummaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AreapeersSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreapeersSummaries) ProtoMessage() {}
+
+func (x *AreapeersSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreapeersSummaries.ProtoReflect.Descriptor instead.
+func (*AreapeersSummaries) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AreapeersSummaries) GetItems() []*AreapeersSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /userquake?aggregate=minute の分ごとの件数
+type UserquakeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time  string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *UserquakeCount) Reset() {
+	*x = UserquakeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeCount) ProtoMessage() {}
+
+func (x *UserquakeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeCount.ProtoReflect.Descriptor instead.
+func (*UserquakeCount) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserquakeCount) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *UserquakeCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UserquakeCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*UserquakeCount `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *UserquakeCounts) Reset() {
+	*x = UserquakeCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeCounts) ProtoMessage() {}
+
+func (x *UserquakeCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeCounts.ProtoReflect.Descriptor instead.
+func (*UserquakeCounts) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserquakeCounts) GetItems() []*UserquakeCount {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 地震感知情報のまとまりに対応する地震情報
+type CorrelatedQuake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time        string   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	IssueTime   string   `protobuf:"bytes,3,opt,name=issue_time,json=issueTime,proto3" json:"issue_time,omitempty"`
+	MaxScale    int32    `protobuf:"varint,4,opt,name=max_scale,json=maxScale,proto3" json:"max_scale,omitempty"`
+	Hypocenter  string   `protobuf:"bytes,5,opt,name=hypocenter,proto3" json:"hypocenter,omitempty"`
+	Magnitude   float64  `protobuf:"fixed64,6,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	LeadTime    float64  `protobuf:"fixed64,7,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	Prefectures []string `protobuf:"bytes,8,rep,name=prefectures,proto3" json:"prefectures,omitempty"`
+}
+
+func (x *CorrelatedQuake) Reset() {
+	*x = CorrelatedQuake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrelatedQuake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrelatedQuake) ProtoMessage() {}
+
+func (x *CorrelatedQuake) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrelatedQuake.ProtoReflect.Descriptor instead.
+func (*CorrelatedQuake) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CorrelatedQuake) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CorrelatedQuake) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *CorrelatedQuake) GetIssueTime() string {
+	if x != nil {
+		return x.IssueTime
+	}
+	return ""
+}
+
+func (x *CorrelatedQuake) GetMaxScale() int32 {
+	if x != nil {
+		return x.MaxScale
+	}
+	return 0
+}
+
+func (x *CorrelatedQuake) GetHypocenter() string {
+	if x != nil {
+		return x.Hypocenter
+	}
+	return ""
+}
+
+func (x *CorrelatedQuake) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *CorrelatedQuake) GetLeadTime() float64 {
+	if x != nil {
+		return x.LeadTime
+	}
+	return 0
+}
+
+func (x *CorrelatedQuake) GetPrefectures() []string {
+	if x != nil {
+		return x.Prefectures
+	}
+	return nil
+}
+
+// /userquake/events の地震感知情報のまとまり
+type UserquakeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartedAt string                     `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt   string                     `protobuf:"bytes,3,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Count     int32                      `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Regions   map[string]int32           `protobuf:"bytes,5,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Prefs     map[string]int32           `protobuf:"bytes,6,rep,name=prefs,proto3" json:"prefs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Areas     map[string]int32           `protobuf:"bytes,7,rep,name=areas,proto3" json:"areas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	RegionsEn map[string]int32           `protobuf:"bytes,8,rep,name=regions_en,json=regionsEn,proto3" json:"regions_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PrefsEn   map[string]int32           `protobuf:"bytes,9,rep,name=prefs_en,json=prefsEn,proto3" json:"prefs_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AreasEn   map[string]int32           `protobuf:"bytes,10,rep,name=areas_en,json=areasEn,proto3" json:"areas_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AreaCodes map[string]int32           `protobuf:"bytes,11,rep,name=area_codes,json=areaCodes,proto3" json:"area_codes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Locations []*UserquakeEvent_Location `protobuf:"bytes,12,rep,name=locations,proto3" json:"locations,omitempty"`
+	Centroid  *UserquakeEvent_Centroid   `protobuf:"bytes,13,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	Quakes    []*CorrelatedQuake         `protobuf:"bytes,14,rep,name=quakes,proto3" json:"quakes,omitempty"`
+	// /eew-detections の userquake_event の場合のみ設定されます.
+	Delay float64 `protobuf:"fixed64,15,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *UserquakeEvent) Reset() {
+	*x = UserquakeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvent) ProtoMessage() {}
+
+func (x *UserquakeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvent.ProtoReflect.Descriptor instead.
+func (*UserquakeEvent) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserquakeEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserquakeEvent) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *UserquakeEvent) GetEndedAt() string {
+	if x != nil {
+		return x.EndedAt
+	}
+	return ""
+}
+
+func (x *UserquakeEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserquakeEvent) GetRegions() map[string]int32 {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetPrefs() map[string]int32 {
+	if x != nil {
+		return x.Prefs
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetAreas() map[string]int32 {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetRegionsEn() map[string]int32 {
+	if x != nil {
+		return x.RegionsEn
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetPrefsEn() map[string]int32 {
+	if x != nil {
+		return x.PrefsEn
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetAreasEn() map[string]int32 {
+	if x != nil {
+		return x.AreasEn
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetAreaCodes() map[string]int32 {
+	if x != nil {
+		return x.AreaCodes
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetLocations() []*UserquakeEvent_Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetCentroid() *UserquakeEvent_Centroid {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetQuakes() []*CorrelatedQuake {
+	if x != nil {
+		return x.Quakes
+	}
+	return nil
+}
+
+func (x *UserquakeEvent) GetDelay() float64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type UserquakeEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*UserquakeEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *UserquakeEvents) Reset() {
+	*x = UserquakeEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvents) ProtoMessage() {}
+
+func (x *UserquakeEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvents.ProtoReflect.Descriptor instead.
+func (*UserquakeEvents) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserquakeEvents) GetItems() []*UserquakeEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /userquake/lead_times の集計
+type UserquakeLeadTimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SinceTime     string                       `protobuf:"bytes,1,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	UntilTime     string                       `protobuf:"bytes,2,opt,name=until_time,json=untilTime,proto3" json:"until_time,omitempty"`
+	Events        int32                        `protobuf:"varint,3,opt,name=events,proto3" json:"events,omitempty"`
+	MatchedEvents int32                        `protobuf:"varint,4,opt,name=matched_events,json=matchedEvents,proto3" json:"matched_events,omitempty"`
+	MatchRate     float64                      `protobuf:"fixed64,5,opt,name=match_rate,json=matchRate,proto3" json:"match_rate,omitempty"`
+	LeadTime      *UserquakeLeadTimes_LeadTime `protobuf:"bytes,6,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+}
+
+func (x *UserquakeLeadTimes) Reset() {
+	*x = UserquakeLeadTimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeLeadTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeLeadTimes) ProtoMessage() {}
+
+func (x *UserquakeLeadTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeLeadTimes.ProtoReflect.Descriptor instead.
+func (*UserquakeLeadTimes) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UserquakeLeadTimes) GetSinceTime() string {
+	if x != nil {
+		return x.SinceTime
+	}
+	return ""
+}
+
+func (x *UserquakeLeadTimes) GetUntilTime() string {
+	if x != nil {
+		return x.UntilTime
+	}
+	return ""
+}
+
+func (x *UserquakeLeadTimes) GetEvents() int32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes) GetMatchedEvents() int32 {
+	if x != nil {
+		return x.MatchedEvents
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes) GetMatchRate() float64 {
+	if x != nil {
+		return x.MatchRate
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes) GetLeadTime() *UserquakeLeadTimes_LeadTime {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+// /eew-detections の発表検出
+type EEWDetectionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           int32                                 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Time           string                                `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Type           string                                `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	TypeEn         string                                `protobuf:"bytes,5,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+	Confirmed      bool                                  `protobuf:"varint,6,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Quakes         []*EEWDetectionSummary_FollowingQuake `protobuf:"bytes,7,rep,name=quakes,proto3" json:"quakes,omitempty"`
+	UserquakeEvent *UserquakeEvent                       `protobuf:"bytes,8,opt,name=userquake_event,json=userquakeEvent,proto3" json:"userquake_event,omitempty"`
+}
+
+func (x *EEWDetectionSummary) Reset() {
+	*x = EEWDetectionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EEWDetectionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EEWDetectionSummary) ProtoMessage() {}
+
+func (x *EEWDetectionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EEWDetectionSummary.ProtoReflect.Descriptor instead.
+func (*EEWDetectionSummary) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EEWDetectionSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EEWDetectionSummary) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *EEWDetectionSummary) GetQuakes() []*EEWDetectionSummary_FollowingQuake {
+	if x != nil {
+		return x.Quakes
+	}
+	return nil
+}
+
+func (x *EEWDetectionSummary) GetUserquakeEvent() *UserquakeEvent {
+	if x != nil {
+		return x.UserquakeEvent
+	}
+	return nil
+}
+
+type EEWDetectionSummaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*EEWDetectionSummary `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *EEWDetectionSummaries) Reset() {
+	*x = EEWDetectionSummaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EEWDetectionSummaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EEWDetectionSummaries) ProtoMessage() {}
+
+func (x *EEWDetectionSummaries) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EEWDetectionSummaries.ProtoReflect.Descriptor instead.
+func (*EEWDetectionSummaries) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EEWDetectionSummaries) GetItems() []*EEWDetectionSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /stats/quakes の集計
+type QuakeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period     string           `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Count      int32            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Scales     map[string]int32 `protobuf:"bytes,3,rep,name=scales,proto3" json:"scales,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ScalesEn   map[string]int32 `protobuf:"bytes,4,rep,name=scales_en,json=scalesEn,proto3" json:"scales_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Magnitudes map[string]int32 `protobuf:"bytes,5,rep,name=magnitudes,proto3" json:"magnitudes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *QuakeStats) Reset() {
+	*x = QuakeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuakeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuakeStats) ProtoMessage() {}
+
+func (x *QuakeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuakeStats.ProtoReflect.Descriptor instead.
+func (*QuakeStats) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuakeStats) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *QuakeStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *QuakeStats) GetScales() map[string]int32 {
+	if x != nil {
+		return x.Scales
+	}
+	return nil
+}
+
+func (x *QuakeStats) GetScalesEn() map[string]int32 {
+	if x != nil {
+		return x.ScalesEn
+	}
+	return nil
+}
+
+func (x *QuakeStats) GetMagnitudes() map[string]int32 {
+	if x != nil {
+		return x.Magnitudes
+	}
+	return nil
+}
+
+type QuakeStatsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*QuakeStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *QuakeStatsList) Reset() {
+	*x = QuakeStatsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuakeStatsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuakeStatsList) ProtoMessage() {}
+
+func (x *QuakeStatsList) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuakeStatsList.ProtoReflect.Descriptor instead.
+func (*QuakeStatsList) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QuakeStatsList) GetItems() []*QuakeStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /stats/prefectures の集計
+type PrefectureStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefecture   string           `protobuf:"bytes,1,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	PrefectureEn string           `protobuf:"bytes,2,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	Count        int32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Scales       map[string]int32 `protobuf:"bytes,4,rep,name=scales,proto3" json:"scales,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ScalesEn     map[string]int32 `protobuf:"bytes,5,rep,name=scales_en,json=scalesEn,proto3" json:"scales_en,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *PrefectureStats) Reset() {
+	*x = PrefectureStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefectureStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefectureStats) ProtoMessage() {}
+
+func (x *PrefectureStats) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefectureStats.ProtoReflect.Descriptor instead.
+func (*PrefectureStats) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PrefectureStats) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *PrefectureStats) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *PrefectureStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PrefectureStats) GetScales() map[string]int32 {
+	if x != nil {
+		return x.Scales
+	}
+	return nil
+}
+
+func (x *PrefectureStats) GetScalesEn() map[string]int32 {
+	if x != nil {
+		return x.ScalesEn
+	}
+	return nil
+}
+
+type PrefectureStatsList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*PrefectureStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *PrefectureStatsList) Reset() {
+	*x = PrefectureStatsList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefectureStatsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefectureStatsList) ProtoMessage() {}
+
+func (x *PrefectureStatsList) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefectureStatsList.ProtoReflect.Descriptor instead.
+func (*PrefectureStatsList) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PrefectureStatsList) GetItems() []*PrefectureStats {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /stats/gutenberg_richter の集計
+type GutenbergRichter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int32                    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Bin          float64                  `protobuf:"fixed64,2,opt,name=bin,proto3" json:"bin,omitempty"`
+	Distribution []*GutenbergRichter_Bin  `protobuf:"bytes,3,rep,name=distribution,proto3" json:"distribution,omitempty"`
+	Mc           *GutenbergRichter_Mc     `protobuf:"bytes,4,opt,name=mc,proto3" json:"mc,omitempty"`
+	BValue       *GutenbergRichter_BValue `protobuf:"bytes,5,opt,name=b_value,json=bValue,proto3" json:"b_value,omitempty"`
+}
+
+func (x *GutenbergRichter) Reset() {
+	*x = GutenbergRichter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GutenbergRichter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GutenbergRichter) ProtoMessage() {}
+
+func (x *GutenbergRichter) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GutenbergRichter.ProtoReflect.Descriptor instead.
+func (*GutenbergRichter) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GutenbergRichter) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GutenbergRichter) GetBin() float64 {
+	if x != nil {
+		return x.Bin
+	}
+	return 0
+}
+
+func (x *GutenbergRichter) GetDistribution() []*GutenbergRichter_Bin {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *GutenbergRichter) GetMc() *GutenbergRichter_Mc {
+	if x != nil {
+		return x.Mc
+	}
+	return nil
+}
+
+func (x *GutenbergRichter) GetBValue() *GutenbergRichter_BValue {
+	if x != nil {
+		return x.BValue
+	}
+	return nil
+}
+
+// /stations の震度観測点
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prefecture   string `protobuf:"bytes,2,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	PrefectureEn string `protobuf:"bytes,3,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	IsArea       bool   `protobuf:"varint,4,opt,name=is_area,json=isArea,proto3" json:"is_area,omitempty"`
+	Count        int32  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	FirstTime    string `protobuf:"bytes,6,opt,name=first_time,json=firstTime,proto3" json:"first_time,omitempty"`
+	LastTime     string `protobuf:"bytes,7,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *Station) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *Station) GetIsArea() bool {
+	if x != nil {
+		return x.IsArea
+	}
+	return false
+}
+
+func (x *Station) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Station) GetFirstTime() string {
+	if x != nil {
+		return x.FirstTime
+	}
+	return ""
+}
+
+func (x *Station) GetLastTime() string {
+	if x != nil {
+		return x.LastTime
+	}
+	return ""
+}
+
+type Stations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Station `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Stations) Reset() {
+	*x = Stations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stations) ProtoMessage() {}
+
+func (x *Stations) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stations.ProtoReflect.Descriptor instead.
+func (*Stations) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Stations) GetItems() []*Station {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /stations/{name}/observations の観測履歴
+type StationObservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type         string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeEn       string  `protobuf:"bytes,3,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+	Time         string  `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Scale        int32   `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	ScaleEn      string  `protobuf:"bytes,6,opt,name=scale_en,json=scaleEn,proto3" json:"scale_en,omitempty"`
+	Prefecture   string  `protobuf:"bytes,7,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	PrefectureEn string  `protobuf:"bytes,8,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	IsArea       bool    `protobuf:"varint,9,opt,name=is_area,json=isArea,proto3" json:"is_area,omitempty"`
+	MaxScale     int32   `protobuf:"varint,10,opt,name=max_scale,json=maxScale,proto3" json:"max_scale,omitempty"`
+	MaxScaleEn   string  `protobuf:"bytes,11,opt,name=max_scale_en,json=maxScaleEn,proto3" json:"max_scale_en,omitempty"`
+	Hypocenter   string  `protobuf:"bytes,12,opt,name=hypocenter,proto3" json:"hypocenter,omitempty"`
+	Latitude     float64 `protobuf:"fixed64,13,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,14,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Depth        float64 `protobuf:"fixed64,15,opt,name=depth,proto3" json:"depth,omitempty"`
+	Magnitude    float64 `protobuf:"fixed64,16,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+}
+
+func (x *StationObservation) Reset() {
+	*x = StationObservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationObservation) ProtoMessage() {}
+
+func (x *StationObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationObservation.ProtoReflect.Descriptor instead.
+func (*StationObservation) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StationObservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StationObservation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StationObservation) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+func (x *StationObservation) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *StationObservation) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *StationObservation) GetScaleEn() string {
+	if x != nil {
+		return x.ScaleEn
+	}
+	return ""
+}
+
+func (x *StationObservation) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *StationObservation) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *StationObservation) GetIsArea() bool {
+	if x != nil {
+		return x.IsArea
+	}
+	return false
+}
+
+func (x *StationObservation) GetMaxScale() int32 {
+	if x != nil {
+		return x.MaxScale
+	}
+	return 0
+}
+
+func (x *StationObservation) GetMaxScaleEn() string {
+	if x != nil {
+		return x.MaxScaleEn
+	}
+	return ""
+}
+
+func (x *StationObservation) GetHypocenter() string {
+	if x != nil {
+		return x.Hypocenter
+	}
+	return ""
+}
+
+func (x *StationObservation) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *StationObservation) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *StationObservation) GetDepth() float64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *StationObservation) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+type StationObservations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*StationObservation `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StationObservations) Reset() {
+	*x = StationObservations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationObservations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationObservations) ProtoMessage() {}
+
+func (x *StationObservations) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationObservations.ProtoReflect.Descriptor instead.
+func (*StationObservations) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StationObservations) GetItems() []*StationObservation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// /jma/quake/{id}/estimated_intensity の推計震度
+type EstimatedIntensity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string                         `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeEn     string                         `protobuf:"bytes,3,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+	Time       string                         `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Hypocenter *EstimatedIntensity_Hypocenter `protobuf:"bytes,5,opt,name=hypocenter,proto3" json:"hypocenter,omitempty"`
+	Model      *EstimatedIntensity_Model      `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	MaxScale   int32                          `protobuf:"varint,7,opt,name=max_scale,json=maxScale,proto3" json:"max_scale,omitempty"`
+	MaxScaleEn string                         `protobuf:"bytes,8,opt,name=max_scale_en,json=maxScaleEn,proto3" json:"max_scale_en,omitempty"`
+	Areas      []*EstimatedIntensity_Area     `protobuf:"bytes,9,rep,name=areas,proto3" json:"areas,omitempty"`
+}
+
+func (x *EstimatedIntensity) Reset() {
+	*x = EstimatedIntensity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatedIntensity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatedIntensity) ProtoMessage() {}
+
+func (x *EstimatedIntensity) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatedIntensity.ProtoReflect.Descriptor instead.
+func (*EstimatedIntensity) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EstimatedIntensity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity) GetHypocenter() *EstimatedIntensity_Hypocenter {
+	if x != nil {
+		return x.Hypocenter
+	}
+	return nil
+}
+
+func (x *EstimatedIntensity) GetModel() *EstimatedIntensity_Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *EstimatedIntensity) GetMaxScale() int32 {
+	if x != nil {
+		return x.MaxScale
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity) GetMaxScaleEn() string {
+	if x != nil {
+		return x.MaxScaleEn
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity) GetAreas() []*EstimatedIntensity_Area {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+type JMAQuake_Issue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Time    string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Correct string `protobuf:"bytes,4,opt,name=correct,proto3" json:"correct,omitempty"`
+	TypeEn  string `protobuf:"bytes,5,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+}
+
+func (x *JMAQuake_Issue) Reset() {
+	*x = JMAQuake_Issue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuake_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuake_Issue) ProtoMessage() {}
+
+func (x *JMAQuake_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuake_Issue.ProtoReflect.Descriptor instead.
+func (*JMAQuake_Issue) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *JMAQuake_Issue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *JMAQuake_Issue) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JMAQuake_Issue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JMAQuake_Issue) GetCorrect() string {
+	if x != nil {
+		return x.Correct
+	}
+	return ""
+}
+
+func (x *JMAQuake_Issue) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+type JMAQuake_Hypocenter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Depth     int32   `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Magnitude float64 `protobuf:"fixed64,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+}
+
+func (x *JMAQuake_Hypocenter) Reset() {
+	*x = JMAQuake_Hypocenter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuake_Hypocenter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuake_Hypocenter) ProtoMessage() {}
+
+func (x *JMAQuake_Hypocenter) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuake_Hypocenter.ProtoReflect.Descriptor instead.
+func (*JMAQuake_Hypocenter) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *JMAQuake_Hypocenter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JMAQuake_Hypocenter) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *JMAQuake_Hypocenter) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *JMAQuake_Hypocenter) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *JMAQuake_Hypocenter) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+type JMAQuake_Earthquake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time              string               `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Hypocenter        *JMAQuake_Hypocenter `protobuf:"bytes,2,opt,name=hypocenter,proto3" json:"hypocenter,omitempty"`
+	MaxScale          int32                `protobuf:"varint,3,opt,name=max_scale,json=maxScale,proto3" json:"max_scale,omitempty"`
+	DomesticTsunami   string               `protobuf:"bytes,4,opt,name=domestic_tsunami,json=domesticTsunami,proto3" json:"domestic_tsunami,omitempty"`
+	ForeignTsunami    string               `protobuf:"bytes,5,opt,name=foreign_tsunami,json=foreignTsunami,proto3" json:"foreign_tsunami,omitempty"`
+	MaxScaleEn        string               `protobuf:"bytes,6,opt,name=max_scale_en,json=maxScaleEn,proto3" json:"max_scale_en,omitempty"`
+	DomesticTsunamiEn string               `protobuf:"bytes,7,opt,name=domestic_tsunami_en,json=domesticTsunamiEn,proto3" json:"domestic_tsunami_en,omitempty"`
+	ForeignTsunamiEn  string               `protobuf:"bytes,8,opt,name=foreign_tsunami_en,json=foreignTsunamiEn,proto3" json:"foreign_tsunami_en,omitempty"`
+}
+
+func (x *JMAQuake_Earthquake) Reset() {
+	*x = JMAQuake_Earthquake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuake_Earthquake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuake_Earthquake) ProtoMessage() {}
+
+func (x *JMAQuake_Earthquake) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuake_Earthquake.ProtoReflect.Descriptor instead.
+func (*JMAQuake_Earthquake) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *JMAQuake_Earthquake) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JMAQuake_Earthquake) GetHypocenter() *JMAQuake_Hypocenter {
+	if x != nil {
+		return x.Hypocenter
+	}
+	return nil
+}
+
+func (x *JMAQuake_Earthquake) GetMaxScale() int32 {
+	if x != nil {
+		return x.MaxScale
+	}
+	return 0
+}
+
+func (x *JMAQuake_Earthquake) GetDomesticTsunami() string {
+	if x != nil {
+		return x.DomesticTsunami
+	}
+	return ""
+}
+
+func (x *JMAQuake_Earthquake) GetForeignTsunami() string {
+	if x != nil {
+		return x.ForeignTsunami
+	}
+	return ""
+}
+
+func (x *JMAQuake_Earthquake) GetMaxScaleEn() string {
+	if x != nil {
+		return x.MaxScaleEn
+	}
+	return ""
+}
+
+func (x *JMAQuake_Earthquake) GetDomesticTsunamiEn() string {
+	if x != nil {
+		return x.DomesticTsunamiEn
+	}
+	return ""
+}
+
+func (x *JMAQuake_Earthquake) GetForeignTsunamiEn() string {
+	if x != nil {
+		return x.ForeignTsunamiEn
+	}
+	return ""
+}
+
+type JMAQuake_Point struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pref    string `protobuf:"bytes,1,opt,name=pref,proto3" json:"pref,omitempty"`
+	Addr    string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	IsArea  bool   `protobuf:"varint,3,opt,name=is_area,json=isArea,proto3" json:"is_area,omitempty"`
+	Scale   int32  `protobuf:"varint,4,opt,name=scale,proto3" json:"scale,omitempty"`
+	PrefEn  string `protobuf:"bytes,5,opt,name=pref_en,json=prefEn,proto3" json:"pref_en,omitempty"`
+	ScaleEn string `protobuf:"bytes,6,opt,name=scale_en,json=scaleEn,proto3" json:"scale_en,omitempty"`
+}
+
+func (x *JMAQuake_Point) Reset() {
+	*x = JMAQuake_Point{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMAQuake_Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMAQuake_Point) ProtoMessage() {}
+
+func (x *JMAQuake_Point) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMAQuake_Point.ProtoReflect.Descriptor instead.
+func (*JMAQuake_Point) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *JMAQuake_Point) GetPref() string {
+	if x != nil {
+		return x.Pref
+	}
+	return ""
+}
+
+func (x *JMAQuake_Point) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *JMAQuake_Point) GetIsArea() bool {
+	if x != nil {
+		return x.IsArea
+	}
+	return false
+}
+
+func (x *JMAQuake_Point) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *JMAQuake_Point) GetPrefEn() string {
+	if x != nil {
+		return x.PrefEn
+	}
+	return ""
+}
+
+func (x *JMAQuake_Point) GetScaleEn() string {
+	if x != nil {
+		return x.ScaleEn
+	}
+	return ""
+}
+
+type JMATsunami_Issue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Time   string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	TypeEn string `protobuf:"bytes,4,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+}
+
+func (x *JMATsunami_Issue) Reset() {
+	*x = JMATsunami_Issue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMATsunami_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMATsunami_Issue) ProtoMessage() {}
+
+func (x *JMATsunami_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMATsunami_Issue.ProtoReflect.Descriptor instead.
+func (*JMATsunami_Issue) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *JMATsunami_Issue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *JMATsunami_Issue) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *JMATsunami_Issue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JMATsunami_Issue) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+type JMATsunami_Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grade     string `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	Immediate bool   `protobuf:"varint,2,opt,name=immediate,proto3" json:"immediate,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	GradeEn   string `protobuf:"bytes,4,opt,name=grade_en,json=gradeEn,proto3" json:"grade_en,omitempty"`
+}
+
+func (x *JMATsunami_Area) Reset() {
+	*x = JMATsunami_Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JMATsunami_Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JMATsunami_Area) ProtoMessage() {}
+
+func (x *JMATsunami_Area) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JMATsunami_Area.ProtoReflect.Descriptor instead.
+func (*JMATsunami_Area) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *JMATsunami_Area) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *JMATsunami_Area) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+func (x *JMATsunami_Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JMATsunami_Area) GetGradeEn() string {
+	if x != nil {
+		return x.GradeEn
+	}
+	return ""
+}
+
+type Areapeers_Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer int32 `protobuf:"varint,2,opt,name=peer,proto3" json:"peer,omitempty"`
+}
+
+func (x *Areapeers_Area) Reset() {
+	*x = Areapeers_Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Areapeers_Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Areapeers_Area) ProtoMessage() {}
+
+func (x *Areapeers_Area) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Areapeers_Area.ProtoReflect.Descriptor instead.
+func (*Areapeers_Area) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Areapeers_Area) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Areapeers_Area) GetPeer() int32 {
+	if x != nil {
+		return x.Peer
+	}
+	return 0
+}
+
+type UserquakeEvaluation_AreaConfidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confidence float64 `protobuf:"fixed64,1,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Count      int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Display    string  `protobuf:"bytes,3,opt,name=display,proto3" json:"display,omitempty"`
+	NameEn     string  `protobuf:"bytes,4,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) Reset() {
+	*x = UserquakeEvaluation_AreaConfidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvaluation_AreaConfidence) ProtoMessage() {}
+
+func (x *UserquakeEvaluation_AreaConfidence) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvaluation_AreaConfidence.ProtoReflect.Descriptor instead.
+func (*UserquakeEvaluation_AreaConfidence) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+func (x *UserquakeEvaluation_AreaConfidence) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+type AreapeersSummary_Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Peer         int32   `protobuf:"varint,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Region       string  `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Prefecture   string  `protobuf:"bytes,4,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	Name         string  `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	RegionEn     string  `protobuf:"bytes,6,opt,name=region_en,json=regionEn,proto3" json:"region_en,omitempty"`
+	PrefectureEn string  `protobuf:"bytes,7,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	NameEn       string  `protobuf:"bytes,8,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Latitude     float64 `protobuf:"fixed64,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,10,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *AreapeersSummary_Area) Reset() {
+	*x = AreapeersSummary_Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AreapeersSummary_Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreapeersSummary_Area) ProtoMessage() {}
+
+func (x *AreapeersSummary_Area) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreapeersSummary_Area.ProtoReflect.Descriptor instead.
+func (*AreapeersSummary_Area) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *AreapeersSummary_Area) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AreapeersSummary_Area) GetPeer() int32 {
+	if x != nil {
+		return x.Peer
+	}
+	return 0
+}
+
+func (x *AreapeersSummary_Area) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetRegionEn() string {
+	if x != nil {
+		return x.RegionEn
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *AreapeersSummary_Area) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *AreapeersSummary_Area) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type UserquakeEvent_Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Area      int32   `protobuf:"varint,1,opt,name=area,proto3" json:"area,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count     int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	NameEn    string  `protobuf:"bytes,6,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+}
+
+func (x *UserquakeEvent_Location) Reset() {
+	*x = UserquakeEvent_Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvent_Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvent_Location) ProtoMessage() {}
+
+func (x *UserquakeEvent_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvent_Location.ProtoReflect.Descriptor instead.
+func (*UserquakeEvent_Location) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *UserquakeEvent_Location) GetArea() int32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *UserquakeEvent_Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserquakeEvent_Location) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserquakeEvent_Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UserquakeEvent_Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UserquakeEvent_Location) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+type UserquakeEvent_Centroid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *UserquakeEvent_Centroid) Reset() {
+	*x = UserquakeEvent_Centroid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeEvent_Centroid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeEvent_Centroid) ProtoMessage() {}
+
+func (x *UserquakeEvent_Centroid) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeEvent_Centroid.ProtoReflect.Descriptor instead.
+func (*UserquakeEvent_Centroid) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *UserquakeEvent_Centroid) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UserquakeEvent_Centroid) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type UserquakeLeadTimes_LeadTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Min    float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Mean   float64 `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median float64 `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	P90    float64 `protobuf:"fixed64,6,opt,name=p90,proto3" json:"p90,omitempty"`
+}
+
+func (x *UserquakeLeadTimes_LeadTime) Reset() {
+	*x = UserquakeLeadTimes_LeadTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserquakeLeadTimes_LeadTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserquakeLeadTimes_LeadTime) ProtoMessage() {}
+
+func (x *UserquakeLeadTimes_LeadTime) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserquakeLeadTimes_LeadTime.ProtoReflect.Descriptor instead.
+func (*UserquakeLeadTimes_LeadTime) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *UserquakeLeadTimes_LeadTime) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+type EEWDetectionSummary_FollowingQuake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeEn     string  `protobuf:"bytes,3,opt,name=type_en,json=typeEn,proto3" json:"type_en,omitempty"`
+	Time       string  `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	MaxScale   int32   `protobuf:"varint,5,opt,name=max_scale,json=maxScale,proto3" json:"max_scale,omitempty"`
+	MaxScaleEn string  `protobuf:"bytes,6,opt,name=max_scale_en,json=maxScaleEn,proto3" json:"max_scale_en,omitempty"`
+	Hypocenter string  `protobuf:"bytes,7,opt,name=hypocenter,proto3" json:"hypocenter,omitempty"`
+	Magnitude  float64 `protobuf:"fixed64,8,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Delay      float64 `protobuf:"fixed64,9,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) Reset() {
+	*x = EEWDetectionSummary_FollowingQuake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EEWDetectionSummary_FollowingQuake) ProtoMessage() {}
+
+func (x *EEWDetectionSummary_FollowingQuake) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EEWDetectionSummary_FollowingQuake.ProtoReflect.Descriptor instead.
+func (*EEWDetectionSummary_FollowingQuake) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetTypeEn() string {
+	if x != nil {
+		return x.TypeEn
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetMaxScale() int32 {
+	if x != nil {
+		return x.MaxScale
+	}
+	return 0
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetMaxScaleEn() string {
+	if x != nil {
+		return x.MaxScaleEn
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetHypocenter() string {
+	if x != nil {
+		return x.Hypocenter
+	}
+	return ""
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *EEWDetectionSummary_FollowingQuake) GetDelay() float64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type GutenbergRichter_Bin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Magnitude  float64 `protobuf:"fixed64,1,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Count      int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Cumulative int32   `protobuf:"varint,3,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+}
+
+func (x *GutenbergRichter_Bin) Reset() {
+	*x = GutenbergRichter_Bin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GutenbergRichter_Bin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GutenbergRichter_Bin) ProtoMessage() {}
+
+func (x *GutenbergRichter_Bin) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GutenbergRichter_Bin.ProtoReflect.Descriptor instead.
+func (*GutenbergRichter_Bin) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *GutenbergRichter_Bin) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_Bin) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_Bin) GetCumulative() int32 {
+	if x != nil {
+		return x.Cumulative
+	}
+	return 0
+}
+
+type GutenbergRichter_Mc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Magnitude float64 `protobuf:"fixed64,1,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Method    string  `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *GutenbergRichter_Mc) Reset() {
+	*x = GutenbergRichter_Mc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GutenbergRichter_Mc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GutenbergRichter_Mc) ProtoMessage() {}
+
+func (x *GutenbergRichter_Mc) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GutenbergRichter_Mc.ProtoReflect.Descriptor instead.
+func (*GutenbergRichter_Mc) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *GutenbergRichter_Mc) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_Mc) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type GutenbergRichter_BValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	B             float64 `protobuf:"fixed64,1,opt,name=b,proto3" json:"b,omitempty"`
+	Uncertainty   float64 `protobuf:"fixed64,2,opt,name=uncertainty,proto3" json:"uncertainty,omitempty"`
+	A             float64 `protobuf:"fixed64,3,opt,name=a,proto3" json:"a,omitempty"`
+	Count         int32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	MeanMagnitude float64 `protobuf:"fixed64,5,opt,name=mean_magnitude,json=meanMagnitude,proto3" json:"mean_magnitude,omitempty"`
+}
+
+func (x *GutenbergRichter_BValue) Reset() {
+	*x = GutenbergRichter_BValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GutenbergRichter_BValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GutenbergRichter_BValue) ProtoMessage() {}
+
+func (x *GutenbergRichter_BValue) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GutenbergRichter_BValue.ProtoReflect.Descriptor instead.
+func (*GutenbergRichter_BValue) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{28, 2}
+}
+
+func (x *GutenbergRichter_BValue) GetB() float64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_BValue) GetUncertainty() float64 {
+	if x != nil {
+		return x.Uncertainty
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_BValue) GetA() float64 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_BValue) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GutenbergRichter_BValue) GetMeanMagnitude() float64 {
+	if x != nil {
+		return x.MeanMagnitude
+	}
+	return 0
+}
+
+type EstimatedIntensity_Hypocenter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Depth     float64 `protobuf:"fixed64,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Magnitude float64 `protobuf:"fixed64,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+}
+
+func (x *EstimatedIntensity_Hypocenter) Reset() {
+	*x = EstimatedIntensity_Hypocenter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatedIntensity_Hypocenter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatedIntensity_Hypocenter) ProtoMessage() {}
+
+func (x *EstimatedIntensity_Hypocenter) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatedIntensity_Hypocenter.ProtoReflect.Descriptor instead.
+func (*EstimatedIntensity_Hypocenter) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *EstimatedIntensity_Hypocenter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Hypocenter) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Hypocenter) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Hypocenter) GetDepth() float64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Hypocenter) GetMagnitude() float64 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+type EstimatedIntensity_Model struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Estimate      bool    `protobuf:"varint,1,opt,name=estimate,proto3" json:"estimate,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FaultType     string  `protobuf:"bytes,3,opt,name=fault_type,json=faultType,proto3" json:"fault_type,omitempty"`
+	Avs30         float64 `protobuf:"fixed64,4,opt,name=avs30,proto3" json:"avs30,omitempty"`
+	Amplification float64 `protobuf:"fixed64,5,opt,name=amplification,proto3" json:"amplification,omitempty"`
+}
+
+func (x *EstimatedIntensity_Model) Reset() {
+	*x = EstimatedIntensity_Model{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatedIntensity_Model) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatedIntensity_Model) ProtoMessage() {}
+
+func (x *EstimatedIntensity_Model) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatedIntensity_Model.ProtoReflect.Descriptor instead.
+func (*EstimatedIntensity_Model) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *EstimatedIntensity_Model) GetEstimate() bool {
+	if x != nil {
+		return x.Estimate
+	}
+	return false
+}
+
+func (x *EstimatedIntensity_Model) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Model) GetFaultType() string {
+	if x != nil {
+		return x.FaultType
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Model) GetAvs30() float64 {
+	if x != nil {
+		return x.Avs30
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Model) GetAmplification() float64 {
+	if x != nil {
+		return x.Amplification
+	}
+	return 0
+}
+
+type EstimatedIntensity_Area struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Region       string  `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	RegionEn     string  `protobuf:"bytes,3,opt,name=region_en,json=regionEn,proto3" json:"region_en,omitempty"`
+	Prefecture   string  `protobuf:"bytes,4,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	PrefectureEn string  `protobuf:"bytes,5,opt,name=prefecture_en,json=prefectureEn,proto3" json:"prefecture_en,omitempty"`
+	Name         string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	NameEn       string  `protobuf:"bytes,7,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Latitude     float64 `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Distance     float64 `protobuf:"fixed64,10,opt,name=distance,proto3" json:"distance,omitempty"`
+	Pgv          float64 `protobuf:"fixed64,11,opt,name=pgv,proto3" json:"pgv,omitempty"`
+	Intensity    float64 `protobuf:"fixed64,12,opt,name=intensity,proto3" json:"intensity,omitempty"`
+	Scale        int32   `protobuf:"varint,13,opt,name=scale,proto3" json:"scale,omitempty"`
+	ScaleEn      string  `protobuf:"bytes,14,opt,name=scale_en,json=scaleEn,proto3" json:"scale_en,omitempty"`
+}
+
+func (x *EstimatedIntensity_Area) Reset() {
+	*x = EstimatedIntensity_Area{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_p2pquake_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimatedIntensity_Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimatedIntensity_Area) ProtoMessage() {}
+
+func (x *EstimatedIntensity_Area) ProtoReflect() protoreflect.Message {
+	mi := &file_p2pquake_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimatedIntensity_Area.ProtoReflect.Descriptor instead.
+func (*EstimatedIntensity_Area) Descriptor() ([]byte, []int) {
+	return file_p2pquake_proto_rawDescGZIP(), []int{33, 2}
+}
+
+func (x *EstimatedIntensity_Area) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetRegionEn() string {
+	if x != nil {
+		return x.RegionEn
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetPrefectureEn() string {
+	if x != nil {
+		return x.PrefectureEn
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *EstimatedIntensity_Area) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetPgv() float64 {
+	if x != nil {
+		return x.Pgv
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetIntensity() float64 {
+	if x != nil {
+		return x.Intensity
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *EstimatedIntensity_Area) GetScaleEn() string {
+	if x != nil {
+		return x.ScaleEn
+	}
+	return ""
+}
+
+var File_p2pquake_proto protoreflect.FileDescriptor
+
+var file_p2pquake_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x07, 0x0a, 0x08,
+	0x4a, 0x4d, 0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d,
+	0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x05, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x61, 0x72, 0x74, 0x68, 0x71, 0x75, 0x61, 0x6b,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x45,
+	0x61, 0x72, 0x74, 0x68, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x52, 0x0a, 0x65, 0x61, 0x72, 0x74, 0x68,
+	0x71, 0x75, 0x61, 0x6b, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x7a, 0x0a, 0x05, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x1a, 0x8e, 0x01, 0x0a, 0x0a, 0x48, 0x79, 0x70, 0x6f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x67,
+	0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61,
+	0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0xd3, 0x02, 0x0a, 0x0a, 0x45, 0x61, 0x72, 0x74,
+	0x68, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x68, 0x79,
+	0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41,
+	0x51, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x48, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x52, 0x0a, 0x68, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x6f, 0x6d,
+	0x65, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x54, 0x73, 0x75,
+	0x6e, 0x61, 0x6d, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f,
+	0x74, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x12,
+	0x2e, 0x0a, 0x13, 0x64, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x73, 0x75, 0x6e,
+	0x61, 0x6d, 0x69, 0x5f, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f,
+	0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x45, 0x6e, 0x12,
+	0x2c, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x73, 0x75, 0x6e, 0x61,
+	0x6d, 0x69, 0x5f, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x45, 0x6e, 0x1a, 0x92, 0x01,
+	0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x73, 0x41, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x66, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x45, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x45, 0x6e, 0x22, 0x38, 0x0a, 0x09, 0x4a, 0x4d, 0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41,
+	0x51, 0x75, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x98, 0x03, 0x0a,
+	0x0a, 0x4a, 0x4d, 0x41, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a,
+	0x4d, 0x41, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52,
+	0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x2e, 0x41,
+	0x72, 0x65, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x1a, 0x60, 0x0a, 0x05, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x1a, 0x69, 0x0a, 0x04,
+	0x41, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6d,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x45, 0x6e, 0x22, 0x3c, 0x0a, 0x0b, 0x4a, 0x4d, 0x41, 0x54, 0x73,
+	0x75, 0x6e, 0x61, 0x6d, 0x69, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5a, 0x0a, 0x0c, 0x45, 0x45, 0x57, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b,
+	0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x2e, 0x41,
+	0x72, 0x65, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x1a, 0x2a, 0x0a, 0x04, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75,
+	0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12,
+	0x17, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x72, 0x65, 0x61, 0x45, 0x6e, 0x22, 0x3a, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
+	0x71, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x93, 0x04, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x60, 0x0a, 0x10, 0x61, 0x72, 0x65, 0x61,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x72, 0x65, 0x61, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x79, 0x0a, 0x0e, 0x41, 0x72,
+	0x65, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x61, 0x6d, 0x65, 0x45, 0x6e, 0x1a, 0x73, 0x0a, 0x14, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x14, 0x55, 0x73,
+	0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x0d, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x09,
+	0x6a, 0x6d, 0x61, 0x5f, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d,
+	0x41, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x6d, 0x61, 0x51, 0x75, 0x61,
+	0x6b, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6a, 0x6d, 0x61, 0x5f, 0x74, 0x73, 0x75, 0x6e, 0x61, 0x6d,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4a, 0x4d, 0x41, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69,
+	0x48, 0x00, 0x52, 0x0a, 0x6a, 0x6d, 0x61, 0x54, 0x73, 0x75, 0x6e, 0x61, 0x6d, 0x69, 0x12, 0x40,
+	0x0a, 0x0d, 0x65, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x45, 0x57, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0c, 0x65, 0x65, 0x77, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x09, 0x61, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61,
+	0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x71, 0x75, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x32,
+	0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75,
+	0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x12, 0x55, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x5f, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x22, 0x3b, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0xc1, 0x01, 0x0a, 0x04, 0x41, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d,
+	0x65, 0x45, 0x6e, 0x22, 0x30, 0x0a, 0x05, 0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x32,
+	0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb2, 0x07, 0x0a, 0x10, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65,
+	0x61, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x70, 0x72, 0x65, 0x66, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70,
+	0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x73, 0x5f, 0x65,
+	0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x1a, 0x8b, 0x02, 0x0a,
+	0x04, 0x41, 0x72, 0x65, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a, 0x12, 0x41, 0x72,
+	0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72,
+	0x65, 0x61, 0x70, 0x65, 0x65, 0x72, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x44, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x68,
+	0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x68, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x65,
+	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xaa, 0x0b, 0x0a, 0x0e, 0x55, 0x73, 0x65,
+	0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71,
+	0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x05, 0x70, 0x72, 0x65, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x72, 0x65, 0x66, 0x73, 0x12, 0x3c, 0x0a,
+	0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70,
+	0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71,
+	0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x12, 0x49, 0x0a, 0x0a, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x12, 0x43, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x73, 0x5f,
+	0x65, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75,
+	0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x70, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x12, 0x43, 0x0a, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x5f, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x73,
+	0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x73, 0x45, 0x6e,
+	0x12, 0x49, 0x0a, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e,
+	0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x40, 0x0a, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x08, 0x63, 0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x06, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x52,
+	0x06, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x9b, 0x01,
+	0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
+	0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x1a, 0x44, 0x0a, 0x08, 0x43,
+	0x65, 0x6e, 0x74, 0x72, 0x6f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a,
+	0x0a, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x41, 0x72, 0x65, 0x61, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x66, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x41,
+	0x72, 0x65, 0x61, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x72, 0x65, 0x61, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfc, 0x02, 0x0a, 0x12,
+	0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x61, 0x74, 0x65, 0x12, 0x45,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x82, 0x01, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x30, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x30, 0x22, 0x9e, 0x04, 0x0a, 0x13, 0x45,
+	0x45, 0x57, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x45, 0x57, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x52, 0x06, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x73, 0x12, 0x44,
+	0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x1a, 0xf4, 0x01, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x79,
+	0x70, 0x65, 0x45, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x53, 0x63, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x79, 0x70, 0x6f, 0x63,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x79, 0x70,
+	0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x67, 0x6e,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x4f, 0x0a, 0x15, 0x45,
+	0x45, 0x57, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x45, 0x57, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbb, 0x03, 0x0a,
+	0x0a, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x32, 0x70, 0x71,
+	0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x73,
+	0x5f, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x32, 0x70, 0x71,
+	0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x6d, 0x61,
+	0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x75, 0x61,
+	0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4d,
+	0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3f, 0x0a, 0x0e, 0x51, 0x75,
+	0x61, 0x6b, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x32,
+	0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x75, 0x61, 0x6b, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xef, 0x02, 0x0a, 0x0f,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x45, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x32, 0x70,
+	0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x09,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x49, 0x0a,
+	0x13, 0x50, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8f, 0x04, 0x0a, 0x10, 0x47, 0x75, 0x74,
+	0x65, 0x6e, 0x62, 0x65, 0x72, 0x67, 0x52, 0x69, 0x63, 0x68, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x62, 0x69, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x32,
+	0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x74, 0x65, 0x6e, 0x62,
+	0x65, 0x72, 0x67, 0x52, 0x69, 0x63, 0x68, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x6e, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x02,
+	0x6d, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75,
+	0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75, 0x74, 0x65, 0x6e, 0x62, 0x65, 0x72, 0x67,
+	0x52, 0x69, 0x63, 0x68, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x63, 0x52, 0x02, 0x6d, 0x63, 0x12, 0x3d,
+	0x0a, 0x07, 0x62, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x75,
+	0x74, 0x65, 0x6e, 0x62, 0x65, 0x72, 0x67, 0x52, 0x69, 0x63, 0x68, 0x74, 0x65, 0x72, 0x2e, 0x42,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x59, 0x0a,
+	0x03, 0x42, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x3a, 0x0a, 0x02, 0x4d, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x1a, 0x83, 0x01, 0x0a, 0x06, 0x42, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x0c, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x62, 0x12, 0x20, 0x0a,
+	0x0b, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12,
+	0x0c, 0x0a, 0x01, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6d, 0x61, 0x67, 0x6e,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x61,
+	0x6e, 0x4d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x07, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x73, 0x41, 0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x08, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0xc1, 0x03, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x45, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x79, 0x70, 0x6f,
+	0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x79,
+	0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x67,
+	0x6e, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x4c, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x8a, 0x08, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0a,
+	0x68, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x79, 0x2e, 0x48, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x68, 0x79,
+	0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61,
+	0x6b, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x45, 0x6e, 0x12, 0x3a, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x52, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73,
+	0x1a, 0x8e, 0x01, 0x0a, 0x0a, 0x48, 0x79, 0x70, 0x6f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x67, 0x6e, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x1a, 0x92, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x76,
+	0x73, 0x33, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x76, 0x73, 0x33, 0x30,
+	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xf8, 0x02, 0x0a, 0x04, 0x41, 0x72, 0x65, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x70, 0x72, 0x65, 0x66, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x67, 0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70,
+	0x67, 0x76, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f,
+	0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x45,
+	0x6e, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x32, 0x70, 0x71, 0x75, 0x61, 0x6b, 0x65, 0x2f, 0x77, 0x65, 0x62, 0x2d, 0x61, 0x70, 0x69,
+	0x2d, 0x76, 0x32, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_p2pquake_proto_rawDescOnce sync.Once
+	file_p2pquake_proto_rawDescData = file_p2pquake_proto_rawDesc
+)
+
+func file_p2pquake_proto_rawDescGZIP() []byte {
+	file_p2pquake_proto_rawDescOnce.Do(func() {
+		file_p2pquake_proto_rawDescData = protoimpl.X.CompressGZIP(file_p2pquake_proto_rawDescData)
+	})
+	return file_p2pquake_proto_rawDescData
+}
+
+var file_p2pquake_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_p2pquake_proto_goTypes = []interface{}{
+	(*JMAQuake)(nil),                           // 0: p2pquake.v2.JMAQuake
+	(*JMAQuakes)(nil),                          // 1: p2pquake.v2.JMAQuakes
+	(*JMATsunami)(nil),                         // 2: p2pquake.v2.JMATsunami
+	(*JMATsunamis)(nil),                        // 3: p2pquake.v2.JMATsunamis
+	(*EEWDetection)(nil),                       // 4: p2pquake.v2.EEWDetection
+	(*Areapeers)(nil),                          // 5: p2pquake.v2.Areapeers
+	(*Userquake)(nil),                          // 6: p2pquake.v2.Userquake
+	(*Userquakes)(nil),                         // 7: p2pquake.v2.Userquakes
+	(*UserquakeEvaluation)(nil),                // 8: p2pquake.v2.UserquakeEvaluation
+	(*UserquakeEvaluations)(nil),               // 9: p2pquake.v2.UserquakeEvaluations
+	(*HistoryRecord)(nil),                      // 10: p2pquake.v2.HistoryRecord
+	(*History)(nil),                            // 11: p2pquake.v2.History
+	(*Area)(nil),                               // 12: p2pquake.v2.Area
+	(*Areas)(nil),                              // 13: p2pquake.v2.Areas
+	(*AreapeersSummary)(nil),                   // 14: p2pquake.v2.AreapeersSummary
+	(*AreapeersSummaries)(nil),                 // 15: p2pquake.v2.AreapeersSummaries
+	(*UserquakeCount)(nil),                     // 16: p2pquake.v2.UserquakeCount
+	(*UserquakeCounts)(nil),                    // 17: p2pquake.v2.UserquakeCounts
+	(*CorrelatedQuake)(nil),                    // 18: p2pquake.v2.CorrelatedQuake
+	(*UserquakeEvent)(nil),                     // 19: p2pquake.v2.UserquakeEvent
+	(*UserquakeEvents)(nil),                    // 20: p2pquake.v2.UserquakeEvents
+	(*UserquakeLeadTimes)(nil),                 // 21: p2pquake.v2.UserquakeLeadTimes
+	(*EEWDetectionSummary)(nil),                // 22: p2pquake.v2.EEWDetectionSummary
+	(*EEWDetectionSummaries)(nil),              // 23: p2pquake.v2.EEWDetectionSummaries
+	(*QuakeStats)(nil),                         // 24: p2pquake.v2.QuakeStats
+	(*QuakeStatsList)(nil),                     // 25: p2pquake.v2.QuakeStatsList
+	(*PrefectureStats)(nil),                    // 26: p2pquake.v2.PrefectureStats
+	(*PrefectureStatsList)(nil),                // 27: p2pquake.v2.PrefectureStatsList
+	(*GutenbergRichter)(nil),                   // 28: p2pquake.v2.GutenbergRichter
+	(*Station)(nil),                            // 29: p2pquake.v2.Station
+	(*Stations)(nil),                           // 30: p2pquake.v2.Stations
+	(*StationObservation)(nil),                 // 31: p2pquake.v2.StationObservation
+	(*StationObservations)(nil),                // 32: p2pquake.v2.StationObservations
+	(*EstimatedIntensity)(nil),                 // 33: p2pquake.v2.EstimatedIntensity
+	(*JMAQuake_Issue)(nil),                     // 34: p2pquake.v2.JMAQuake.Issue
+	(*JMAQuake_Hypocenter)(nil),                // 35: p2pquake.v2.JMAQuake.Hypocenter
+	(*JMAQuake_Earthquake)(nil),                // 36: p2pquake.v2.JMAQuake.Earthquake
+	(*JMAQuake_Point)(nil),                     // 37: p2pquake.v2.JMAQuake.Point
+	(*JMATsunami_Issue)(nil),                   // 38: p2pquake.v2.JMATsunami.Issue
+	(*JMATsunami_Area)(nil),                    // 39: p2pquake.v2.JMATsunami.Area
+	(*Areapeers_Area)(nil),                     // 40: p2pquake.v2.Areapeers.Area
+	(*UserquakeEvaluation_AreaConfidence)(nil), // 41: p2pquake.v2.UserquakeEvaluation.AreaConfidence
+	nil,                                 // 42: p2pquake.v2.UserquakeEvaluation.AreaConfidencesEntry
+	(*AreapeersSummary_Area)(nil),       // 43: p2pquake.v2.AreapeersSummary.Area
+	nil,                                 // 44: p2pquake.v2.AreapeersSummary.RegionsEntry
+	nil,                                 // 45: p2pquake.v2.AreapeersSummary.PrefsEntry
+	nil,                                 // 46: p2pquake.v2.AreapeersSummary.RegionsEnEntry
+	nil,                                 // 47: p2pquake.v2.AreapeersSummary.PrefsEnEntry
+	(*UserquakeEvent_Location)(nil),     // 48: p2pquake.v2.UserquakeEvent.Location
+	(*UserquakeEvent_Centroid)(nil),     // 49: p2pquake.v2.UserquakeEvent.Centroid
+	nil,                                 // 50: p2pquake.v2.UserquakeEvent.RegionsEntry
+	nil,                                 // 51: p2pquake.v2.UserquakeEvent.PrefsEntry
+	nil,                                 // 52: p2pquake.v2.UserquakeEvent.AreasEntry
+	nil,                                 // 53: p2pquake.v2.UserquakeEvent.RegionsEnEntry
+	nil,                                 // 54: p2pquake.v2.UserquakeEvent.PrefsEnEntry
+	nil,                                 // 55: p2pquake.v2.UserquakeEvent.AreasEnEntry
+	nil,                                 // 56: p2pquake.v2.UserquakeEvent.AreaCodesEntry
+	(*UserquakeLeadTimes_LeadTime)(nil), // 57: p2pquake.v2.UserquakeLeadTimes.LeadTime
+	(*EEWDetectionSummary_FollowingQuake)(nil), // 58: p2pquake.v2.EEWDetectionSummary.FollowingQuake
+	nil,                                   // 59: p2pquake.v2.QuakeStats.ScalesEntry
+	nil,                                   // 60: p2pquake.v2.QuakeStats.ScalesEnEntry
+	nil,                                   // 61: p2pquake.v2.QuakeStats.MagnitudesEntry
+	nil,                                   // 62: p2pquake.v2.PrefectureStats.ScalesEntry
+	nil,                                   // 63: p2pquake.v2.PrefectureStats.ScalesEnEntry
+	(*GutenbergRichter_Bin)(nil),          // 64: p2pquake.v2.GutenbergRichter.Bin
+	(*GutenbergRichter_Mc)(nil),           // 65: p2pquake.v2.GutenbergRichter.Mc
+	(*GutenbergRichter_BValue)(nil),       // 66: p2pquake.v2.GutenbergRichter.BValue
+	(*EstimatedIntensity_Hypocenter)(nil), // 67: p2pquake.v2.EstimatedIntensity.Hypocenter
+	(*EstimatedIntensity_Model)(nil),      // 68: p2pquake.v2.EstimatedIntensity.Model
+	(*EstimatedIntensity_Area)(nil),       // 69: p2pquake.v2.EstimatedIntensity.Area
+	(*structpb.Struct)(nil),               // 70: google.protobuf.Struct
+}
+var file_p2pquake_proto_depIdxs = []int32{
+	34, // 0: p2pquake.v2.JMAQuake.issue:type_name -> p2pquake.v2.JMAQuake.Issue
+	36, // 1: p2pquake.v2.JMAQuake.earthquake:type_name -> p2pquake.v2.JMAQuake.Earthquake
+	37, // 2: p2pquake.v2.JMAQuake.points:type_name -> p2pquake.v2.JMAQuake.Point
+	0,  // 3: p2pquake.v2.JMAQuakes.items:type_name -> p2pquake.v2.JMAQuake
+	38, // 4: p2pquake.v2.JMATsunami.issue:type_name -> p2pquake.v2.JMATsunami.Issue
+	39, // 5: p2pquake.v2.JMATsunami.areas:type_name -> p2pquake.v2.JMATsunami.Area
+	2,  // 6: p2pquake.v2.JMATsunamis.items:type_name -> p2pquake.v2.JMATsunami
+	40, // 7: p2pquake.v2.Areapeers.areas:type_name -> p2pquake.v2.Areapeers.Area
+	6,  // 8: p2pquake.v2.Userquakes.items:type_name -> p2pquake.v2.Userquake
+	42, // 9: p2pquake.v2.UserquakeEvaluation.area_confidences:type_name -> p2pquake.v2.UserquakeEvaluation.AreaConfidencesEntry
+	8,  // 10: p2pquake.v2.UserquakeEvaluations.items:type_name -> p2pquake.v2.UserquakeEvaluation
+	0,  // 11: p2pquake.v2.HistoryRecord.jma_quake:type_name -> p2pquake.v2.JMAQuake
+	2,  // 12: p2pquake.v2.HistoryRecord.jma_tsunami:type_name -> p2pquake.v2.JMATsunami
+	4,  // 13: p2pquake.v2.HistoryRecord.eew_detection:type_name -> p2pquake.v2.EEWDetection
+	5,  // 14: p2pquake.v2.HistoryRecord.areapeers:type_name -> p2pquake.v2.Areapeers
+	6,  // 15: p2pquake.v2.HistoryRecord.userquake:type_name -> p2pquake.v2.Userquake
+	8,  // 16: p2pquake.v2.HistoryRecord.userquake_evaluation:type_name -> p2pquake.v2.UserquakeEvaluation
+	70, // 17: p2pquake.v2.HistoryRecord.other:type_name -> google.protobuf.Struct
+	10, // 18: p2pquake.v2.History.items:type_name -> p2pquake.v2.HistoryRecord
+	12, // 19: p2pquake.v2.Areas.items:type_name -> p2pquake.v2.Area
+	43, // 20: p2pquake.v2.AreapeersSummary.areas:type_name -> p2pquake.v2.AreapeersSummary.Area
+	44, // 21: p2pquake.v2.AreapeersSummary.regions:type_name -> p2pquake.v2.AreapeersSummary.RegionsEntry
+	45, // 22: p2pquake.v2.AreapeersSummary.prefs:type_name -> p2pquake.v2.AreapeersSummary.PrefsEntry
+	46, // 23: p2pquake.v2.AreapeersSummary.regions_en:type_name -> p2pquake.v2.AreapeersSummary.RegionsEnEntry
+	47, // 24: p2pquake.v2.AreapeersSummary.prefs_en:type_name -> p2pquake.v2.AreapeersSummary.PrefsEnEntry
+	14, // 25: p2pquake.v2.AreapeersSummaries.items:type_name -> p2pquake.v2.AreapeersSummary
+	16, // 26: p2pquake.v2.UserquakeCounts.items:type_name -> p2pquake.v2.UserquakeCount
+	50, // 27: p2pquake.v2.UserquakeEvent.regions:type_name -> p2pquake.v2.UserquakeEvent.RegionsEntry
+	51, // 28: p2pquake.v2.UserquakeEvent.prefs:type_name -> p2pquake.v2.UserquakeEvent.PrefsEntry
+	52, // 29: p2pquake.v2.UserquakeEvent.areas:type_name -> p2pquake.v2.UserquakeEvent.AreasEntry
+	53, // 30: p2pquake.v2.UserquakeEvent.regions_en:type_name -> p2pquake.v2.UserquakeEvent.RegionsEnEntry
+	54, // 31: p2pquake.v2.UserquakeEvent.prefs_en:type_name -> p2pquake.v2.UserquakeEvent.PrefsEnEntry
+	55, // 32: p2pquake.v2.UserquakeEvent.areas_en:type_name -> p2pquake.v2.UserquakeEvent.AreasEnEntry
+	56, // 33: p2pquake.v2.UserquakeEvent.area_codes:type_name -> p2pquake.v2.UserquakeEvent.AreaCodesEntry
+	48, // 34: p2pquake.v2.UserquakeEvent.locations:type_name -> p2pquake.v2.UserquakeEvent.Location
+	49, // 35: p2pquake.v2.UserquakeEvent.centroid:type_name -> p2pquake.v2.UserquakeEvent.Centroid
+	18, // 36: p2pquake.v2.UserquakeEvent.quakes:type_name -> p2pquake.v2.CorrelatedQuake
+	19, // 37: p2pquake.v2.UserquakeEvents.items:type_name -> p2pquake.v2.UserquakeEvent
+	57, // 38: p2pquake.v2.UserquakeLeadTimes.lead_time:type_name -> p2pquake.v2.UserquakeLeadTimes.LeadTime
+	58, // 39: p2pquake.v2.EEWDetectionSummary.quakes:type_name -> p2pquake.v2.EEWDetectionSummary.FollowingQuake
+	19, // 40: p2pquake.v2.EEWDetectionSummary.userquake_event:type_name -> p2pquake.v2.UserquakeEvent
+	22, // 41: p2pquake.v2.EEWDetectionSummaries.items:type_name -> p2pquake.v2.EEWDetectionSummary
+	59, // 42: p2pquake.v2.QuakeStats.scales:type_name -> p2pquake.v2.QuakeStats.ScalesEntry
+	60, // 43: p2pquake.v2.QuakeStats.scales_en:type_name -> p2pquake.v2.QuakeStats.ScalesEnEntry
+	61, // 44: p2pquake.v2.QuakeStats.magnitudes:type_name -> p2pquake.v2.QuakeStats.MagnitudesEntry
+	24, // 45: p2pquake.v2.QuakeStatsList.items:type_name -> p2pquake.v2.QuakeStats
+	62, // 46: p2pquake.v2.PrefectureStats.scales:type_name -> p2pquake.v2.PrefectureStats.ScalesEntry
+	63, // 47: p2pquake.v2.PrefectureStats.scales_en:type_name -> p2pquake.v2.PrefectureStats.ScalesEnEntry
+	26, // 48: p2pquake.v2.PrefectureStatsList.items:type_name -> p2pquake.v2.PrefectureStats
+	64, // 49: p2pquake.v2.GutenbergRichter.distribution:type_name -> p2pquake.v2.GutenbergRichter.Bin
+	65, // 50: p2pquake.v2.GutenbergRichter.mc:type_name -> p2pquake.v2.GutenbergRichter.Mc
+	66, // 51: p2pquake.v2.GutenbergRichter.b_value:type_name -> p2pquake.v2.GutenbergRichter.BValue
+	29, // 52: p2pquake.v2.Stations.items:type_name -> p2pquake.v2.Station
+	31, // 53: p2pquake.v2.StationObservations.items:type_name -> p2pquake.v2.StationObservation
+	67, // 54: p2pquake.v2.EstimatedIntensity.hypocenter:type_name -> p2pquake.v2.EstimatedIntensity.Hypocenter
+	68, // 55: p2pquake.v2.EstimatedIntensity.model:type_name -> p2pquake.v2.EstimatedIntensity.Model
+	69, // 56: p2pquake.v2.EstimatedIntensity.areas:type_name -> p2pquake.v2.EstimatedIntensity.Area
+	35, // 57: p2pquake.v2.JMAQuake.Earthquake.hypocenter:type_name -> p2pquake.v2.JMAQuake.Hypocenter
+	41, // 58: p2pquake.v2.UserquakeEvaluation.AreaConfidencesEntry.value:type_name -> p2pquake.v2.UserquakeEvaluation.AreaConfidence
+	59, // [59:59] is the sub-list for method output_type
+	59, // [59:59] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_p2pquake_proto_init() }
+func file_p2pquake_proto_init() {
+	if File_p2pquake_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_p2pquake_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuakes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMATsunami); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMATsunamis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EEWDetection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Areapeers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Userquake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Userquakes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvaluation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvaluations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*History); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Areas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreapeersSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreapeersSummaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrelatedQuake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeLeadTimes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EEWDetectionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EEWDetectionSummaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuakeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuakeStatsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefectureStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefectureStatsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GutenbergRichter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationObservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationObservations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatedIntensity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuake_Issue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuake_Hypocenter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuake_Earthquake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMAQuake_Point); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMATsunami_Issue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JMATsunami_Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Areapeers_Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvaluation_AreaConfidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreapeersSummary_Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvent_Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeEvent_Centroid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserquakeLeadTimes_LeadTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EEWDetectionSummary_FollowingQuake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GutenbergRichter_Bin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GutenbergRichter_Mc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GutenbergRichter_BValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatedIntensity_Hypocenter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatedIntensity_Model); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_p2pquake_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimatedIntensity_Area); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_p2pquake_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*HistoryRecord_JmaQuake)(nil),
+		(*HistoryRecord_JmaTsunami)(nil),
+		(*HistoryRecord_EewDetection)(nil),
+		(*HistoryRecord_Areapeers)(nil),
+		(*HistoryRecord_Userquake)(nil),
+		(*HistoryRecord_UserquakeEvaluation)(nil),
+		(*HistoryRecord_Other)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_p2pquake_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   70,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_p2pquake_proto_goTypes,
+		DependencyIndexes: file_p2pquake_proto_depIdxs,
+		MessageInfos:      file_p2pquake_proto_msgTypes,
+	}.Build()
+	File_p2pquake_proto = out.File
+	file_p2pquake_proto_rawDesc = nil
+	file_p2pquake_proto_goTypes = nil
+	file_p2pquake_proto_depIdxs = nil
+}
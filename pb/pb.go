@@ -0,0 +1,9 @@
+// Package pb は P2P地震情報 API のレスポンスの Protocol Buffers 定義です.
+package pb
+
+import _ "embed"
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative p2pquake.proto
+
+//go:embed p2pquake.proto
+var Proto []byte
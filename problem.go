@@ -0,0 +1,229 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const mimeProblemJSON = "application/problem+json"
+
+// Problem は RFC 7807 のエラーレスポンスを表す.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// InvalidParam は誤りのあるパラメタと、違反したルール、許容される値を表す.
+type InvalidParam struct {
+	Name    string        `json:"name"`
+	Reason  string        `json:"reason"`
+	Rule    string        `json:"rule"`
+	Value   string        `json:"value,omitempty"`
+	Allowed []interface{} `json:"allowed,omitempty"`
+}
+
+// crossFieldValidator は複数のパラメタにまたがる検証を行うパラメタ構造体が実装する.
+// 検証のみを行い、パラメタ構造体は変更しない. 期間などの解釈した値は、ハンドラが別のメソッドで取り出す.
+type crossFieldValidator interface {
+	validateCrossFields() []InvalidParam
+}
+
+func respondProblem(c *gin.Context, status int, detail string, invalidParams ...InvalidParam) {
+	c.Header("Content-Type", mimeProblemJSON)
+	c.AbortWithStatusJSON(status, Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(status),
+		Status:        status,
+		Detail:        detail,
+		Instance:      c.Request.URL.RequestURI(),
+		InvalidParams: invalidParams,
+	})
+}
+
+// respondNoRoute は該当するパスのないリクエストに 404 を返却する.
+// /fdsnws は FDSN Web Services の形式、それ以外は problem+json で返却する.
+func respondNoRoute(c *gin.Context) {
+	if strings.HasPrefix(c.Request.URL.Path, "/fdsnws/") {
+		fdsnError(c, 404, "no such resource")
+		return
+	}
+	respondProblem(c, 404, "no such resource")
+}
+
+// respondNoMethod はパスに対応していないメソッドのリクエストに 405 を返却する. 形式は respondNoRoute と同じ.
+func respondNoMethod(c *gin.Context) {
+	if strings.HasPrefix(c.Request.URL.Path, "/fdsnws/") {
+		fdsnError(c, 405, "method not allowed")
+		return
+	}
+	respondProblem(c, 405, "method not allowed")
+}
+
+// bindQuery はクエリパラメタを paramStruct に割り当てて検証する. 誤りがあればエラーレスポンスを返却して false を返す.
+func bindQuery(c *gin.Context, paramStruct interface{}) bool {
+	if extraKeys := validateQueryParams(c, paramStruct); len(extraKeys) > 0 {
+		var invalidParams []InvalidParam
+		for _, key := range extraKeys {
+			invalidParams = append(invalidParams, InvalidParam{Name: key, Reason: "unknown parameter", Rule: "unknown"})
+		}
+		respondProblem(c, 400, "extra keys found", invalidParams...)
+		return false
+	}
+
+	if err := c.ShouldBindWith(paramStruct, binding.Query); err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			respondProblem(c, 400, "invalid parameters", toInvalidParams(paramStruct, validationErrors)...)
+			return false
+		}
+		respondProblem(c, 400, "invalid parameters", typeErrors(c, paramStruct)...)
+		return false
+	}
+
+	if v, ok := paramStruct.(crossFieldValidator); ok {
+		if invalidParams := v.validateCrossFields(); len(invalidParams) > 0 {
+			respondProblem(c, 400, "invalid parameters", invalidParams...)
+			return false
+		}
+	}
+
+	return true
+}
+
+func toInvalidParams(paramStruct interface{}, validationErrors validator.ValidationErrors) []InvalidParam {
+	t := reflect.TypeOf(paramStruct)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var invalidParams []InvalidParam
+	for _, fe := range validationErrors {
+		fieldName := fe.StructField()
+		if i := strings.Index(fieldName, "["); i >= 0 {
+			fieldName = fieldName[:i]
+		}
+		name := fieldName
+		if field, ok := t.FieldByName(fieldName); ok {
+			if formTag := field.Tag.Get("form"); formTag != "" {
+				name = formTag
+			}
+		}
+
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+
+		invalidParams = append(invalidParams, InvalidParam{
+			Name:    name,
+			Reason:  ruleReason(fe),
+			Rule:    rule,
+			Value:   fmt.Sprint(fe.Value()),
+			Allowed: allowedValues(fe),
+		})
+	}
+	return invalidParams
+}
+
+func ruleReason(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "min":
+		return "must be greater than or equal to " + fe.Param()
+	case "max":
+		return "must be less than or equal to " + fe.Param()
+	case "len":
+		return "length must be " + fe.Param()
+	case "numeric":
+		return "must be numeric"
+	case "contains":
+		return "must contain " + strconv.Quote(containsParam(fe.Param()))
+	case "oneof":
+		return "must be one of the allowed values"
+	}
+	return "must satisfy " + fe.Tag()
+}
+
+func containsParam(param string) string {
+	if strings.HasPrefix(param, "0x") {
+		if r, err := strconv.ParseInt(param[2:], 16, 32); err == nil {
+			return string(rune(r))
+		}
+	}
+	return param
+}
+
+func allowedValues(fe validator.FieldError) []interface{} {
+	var allowed []interface{}
+	switch fe.Tag() {
+	case "oneof":
+		for _, value := range strings.Fields(fe.Param()) {
+			if i, err := strconv.Atoi(value); err == nil {
+				allowed = append(allowed, i)
+			} else {
+				allowed = append(allowed, value)
+			}
+		}
+	}
+	return allowed
+}
+
+// typeErrors は型変換に失敗したパラメタを特定する.
+func typeErrors(c *gin.Context, paramStruct interface{}) []InvalidParam {
+	t := reflect.TypeOf(paramStruct)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	query := c.Request.URL.Query()
+	var invalidParams []InvalidParam
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("form")
+		kind := field.Type.Kind()
+		if kind == reflect.Slice || kind == reflect.Ptr {
+			kind = field.Type.Elem().Kind()
+		}
+
+		for _, value := range query[name] {
+			var err error
+			var rule string
+			switch kind {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				_, err = strconv.ParseInt(value, 10, 64)
+				rule = "integer"
+			case reflect.Float32, reflect.Float64:
+				_, err = strconv.ParseFloat(value, 64)
+				rule = "number"
+			case reflect.Bool:
+				_, err = strconv.ParseBool(value)
+				rule = "boolean"
+			}
+			if err != nil {
+				invalidParams = append(invalidParams, InvalidParam{Name: name, Reason: "must be " + rule, Rule: rule, Value: value})
+				break
+			}
+		}
+	}
+	return invalidParams
+}
+
+func orderedFieldError(name string, otherName string, value interface{}) InvalidParam {
+	return InvalidParam{
+		Name:   name,
+		Reason: "must be less than or equal to " + otherName,
+		Rule:   "ltefield=" + otherName,
+		Value:  fmt.Sprint(value),
+	}
+}
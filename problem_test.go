@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNoRoute(t *testing.T) {
+	if err := loadOpenAPI("http://localhost"); err != nil {
+		t.Fatalf("openapi load error: %v", err)
+	}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r)
+
+	tests := []struct {
+		method      string
+		path        string
+		status      int
+		contentType string
+	}{
+		{"GET", "/v2/unknown", 404, mimeProblemJSON},
+		{"GET", "/unknown", 404, mimeProblemJSON},
+		{"POST", "/v2/areas", 405, mimeProblemJSON},
+		{"GET", "/fdsnws/event/1/unknown", 404, "text/plain"},
+		{"POST", "/fdsnws/event/1/version", 405, "text/plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+			if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, tt.contentType) {
+				t.Errorf("Content-Type = %q, want %q", contentType, tt.contentType)
+			}
+			if tt.contentType != mimeProblemJSON {
+				return
+			}
+			var problem Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("problem decode error: %v (%s)", err, w.Body.String())
+			}
+			if problem.Status != tt.status || problem.Instance != tt.path {
+				t.Errorf("problem = %+v, want status %d and instance %s", problem, tt.status, tt.path)
+			}
+		})
+	}
+}
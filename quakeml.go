@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const quakeMLResourcePrefix = "smi:p2pquake.net/jma/quake"
+
+type quakeMLDocument struct {
+	XMLName         xml.Name               `xml:"q:quakeml"`
+	XMLNS           string                 `xml:"xmlns,attr"`
+	XMLNSQ          string                 `xml:"xmlns:q,attr"`
+	EventParameters quakeMLEventParameters `xml:"eventParameters"`
+}
+
+type quakeMLEventParameters struct {
+	PublicID string         `xml:"publicID,attr"`
+	Events   []quakeMLEvent `xml:"event"`
+}
+
+type quakeMLEvent struct {
+	PublicID             string              `xml:"publicID,attr"`
+	PreferredOriginID    string              `xml:"preferredOriginID,omitempty"`
+	PreferredMagnitudeID string              `xml:"preferredMagnitudeID,omitempty"`
+	Type                 string              `xml:"type"`
+	Description          *quakeMLDescription `xml:"description,omitempty"`
+	CreationInfo         quakeMLCreationInfo `xml:"creationInfo"`
+	Origin               *quakeMLOrigin      `xml:"origin,omitempty"`
+	Magnitude            *quakeMLMagnitude   `xml:"magnitude,omitempty"`
+	Amplitudes           []quakeMLAmplitude  `xml:"amplitude"`
+}
+
+type quakeMLDescription struct {
+	Text string `xml:"text"`
+	Type string `xml:"type"`
+}
+
+type quakeMLCreationInfo struct {
+	AgencyID     string `xml:"agencyID"`
+	CreationTime string `xml:"creationTime,omitempty"`
+}
+
+type quakeMLTimeQuantity struct {
+	Value string `xml:"value"`
+}
+
+type quakeMLRealQuantity struct {
+	Value float64 `xml:"value"`
+}
+
+type quakeMLOrigin struct {
+	PublicID  string               `xml:"publicID,attr"`
+	Time      quakeMLTimeQuantity  `xml:"time"`
+	Latitude  quakeMLRealQuantity  `xml:"latitude"`
+	Longitude quakeMLRealQuantity  `xml:"longitude"`
+	Depth     *quakeMLRealQuantity `xml:"depth,omitempty"`
+}
+
+type quakeMLMagnitude struct {
+	PublicID string              `xml:"publicID,attr"`
+	Mag      quakeMLRealQuantity `xml:"mag"`
+	Type     string              `xml:"type"`
+	OriginID string              `xml:"originID,omitempty"`
+}
+
+type quakeMLComment struct {
+	Text string `xml:"text"`
+}
+
+type quakeMLAmplitude struct {
+	PublicID         string              `xml:"publicID,attr"`
+	GenericAmplitude quakeMLRealQuantity `xml:"genericAmplitude"`
+	Type             string              `xml:"type"`
+	Unit             string              `xml:"unit"`
+	Comment          quakeMLComment      `xml:"comment"`
+}
+
+func renderQuakeML(c *gin.Context, items []bson.M) {
+	document := quakeMLDocument{
+		XMLNS:  "http://quakeml.org/xmlns/bed/1.2",
+		XMLNSQ: "http://quakeml.org/xmlns/quakeml/1.2",
+		EventParameters: quakeMLEventParameters{
+			PublicID: quakeMLResourcePrefix,
+			Events:   make([]quakeMLEvent, 0, len(items)),
+		},
+	}
+	for _, item := range items {
+		document.EventParameters.Events = append(document.EventParameters.Events, toQuakeMLEvent(item))
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		respondProblem(c, 500, "response encoding error")
+		return
+	}
+	c.Data(200, "application/xml; charset=utf-8", append([]byte(xml.Header), body...))
+}
+
+func toQuakeMLEvent(item bson.M) quakeMLEvent {
+	resourceID := quakeMLResourcePrefix + "/" + recordID(item)
+	event := quakeMLEvent{
+		PublicID:     resourceID,
+		Type:         "earthquake",
+		CreationInfo: quakeMLCreationInfo{AgencyID: "JMA"},
+	}
+
+	if issue := recordMap(item, "issue"); issue != nil {
+		event.CreationInfo.CreationTime = quakeMLTime(recordString(issue, "time"))
+	}
+
+	earthquake := recordMap(item, "earthquake")
+	hypocenter := recordMap(item, "earthquake.hypocenter")
+	if hypocenter != nil {
+		if name := recordString(hypocenter, "name"); name != "" {
+			event.Description = &quakeMLDescription{Text: name, Type: "region name"}
+		}
+
+		latitude, okLatitude := recordFloat(hypocenter, "latitude")
+		longitude, okLongitude := recordFloat(hypocenter, "longitude")
+		originTime := quakeMLTime(recordString(earthquake, "time"))
+		// 震源情報が存在しない場合、緯度・経度は -200 となる.
+		if okLatitude && okLongitude && latitude > -200 && longitude > -200 && originTime != "" {
+			origin := quakeMLOrigin{
+				PublicID:  resourceID + "/origin",
+				Time:      quakeMLTimeQuantity{Value: originTime},
+				Latitude:  quakeMLRealQuantity{Value: latitude},
+				Longitude: quakeMLRealQuantity{Value: longitude},
+			}
+			if depth, ok := recordFloat(hypocenter, "depth"); ok && depth >= 0 {
+				origin.Depth = &quakeMLRealQuantity{Value: depth * 1000}
+			}
+			event.Origin = &origin
+			event.PreferredOriginID = origin.PublicID
+		}
+
+		if magnitude, ok := recordFloat(hypocenter, "magnitude"); ok && magnitude >= 0 {
+			event.Magnitude = &quakeMLMagnitude{
+				PublicID: resourceID + "/magnitude",
+				Mag:      quakeMLRealQuantity{Value: magnitude},
+				Type:     "Mj",
+				OriginID: event.PreferredOriginID,
+			}
+			event.PreferredMagnitudeID = event.Magnitude.PublicID
+		}
+	}
+
+	for i, point := range recordArray(item, "points") {
+		scale, _ := recordInt(point, "scale")
+		event.Amplitudes = append(event.Amplitudes, quakeMLAmplitude{
+			PublicID:         resourceID + "/amplitude/" + strconv.Itoa(i),
+			GenericAmplitude: quakeMLRealQuantity{Value: float64(scale)},
+			Type:             "JMA seismic intensity (P2PQuake scale code)",
+			Unit:             "other",
+			Comment: quakeMLComment{
+				Text: fmt.Sprintf("%s %s (%s)", recordString(point, "pref"), recordString(point, "addr"), scaleNames[scale]),
+			},
+		})
+	}
+
+	return event
+}
+
+func quakeMLTime(s string) string {
+	t, err := parseJSTTime(s)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
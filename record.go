@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+// parseJSTTime は `2006/01/02 15:04:05` (ミリ秒付きも可) 形式の日時を日本時間として解釈する.
+func parseJSTTime(s string) (time.Time, error) {
+	if len(s) > 19 {
+		return time.ParseInLocation("2006/01/02 15:04:05.999", s, jst)
+	}
+	return time.ParseInLocation("2006/01/02 15:04:05", s, jst)
+}
+
+func recordMap(m primitive.M, path string) primitive.M {
+	for _, key := range strings.Split(path, ".") {
+		child, ok := m[key].(primitive.M)
+		if !ok {
+			return nil
+		}
+		m = child
+	}
+	return m
+}
+
+func recordString(m primitive.M, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func recordFloat(m primitive.M, key string) (float64, bool) {
+	switch v := m[key].(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func recordInt(m primitive.M, key string) (int, bool) {
+	f, ok := recordFloat(m, key)
+	return int(f), ok
+}
+
+func recordArray(m primitive.M, key string) []primitive.M {
+	a, ok := m[key].(primitive.A)
+	if !ok {
+		return nil
+	}
+	items := make([]primitive.M, 0, len(a))
+	for _, v := range a {
+		if item, ok := v.(primitive.M); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func recordID(m primitive.M) string {
+	switch id := m["id"].(type) {
+	case primitive.ObjectID:
+		return id.Hex()
+	case string:
+		return id
+	}
+	if id, ok := m["_id"].(primitive.ObjectID); ok {
+		return id.Hex()
+	}
+	return ""
+}
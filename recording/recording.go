@@ -0,0 +1,182 @@
+// Package recording はリクエストとレスポンスの概要を JSONL 形式で記録・読み込みするパッケージです.
+// 記録したファイルを cmd/replay で別のビルドに対して再生し、レスポンスの差異を検出します.
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Entry は 1 件のリクエストの記録を表す.
+// 再現に必要な情報のみを記録し、 IP アドレスや User-Agent などのヘッダは記録しない.
+// Hash が空の記録は、レスポンスボディが再現できないため、ステータスコードのみ比較する.
+type Entry struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Accept string `json:"accept,omitempty"`
+	Status int    `json:"status"`
+	Hash   string `json:"hash,omitempty"`
+}
+
+// RedactedValue は記録しないクエリパラメタの値の代わりに記録する値.
+const RedactedValue = "REDACTED"
+
+// skipHashKey はレスポンスボディのハッシュ値を記録しないことを示す gin.Context のキー.
+const skipHashKey = "recording.skipHash"
+
+// QueryParams はリクエストに対して、値を記録してよいクエリパラメタの名前を返す.
+type QueryParams func(method string, path string) []string
+
+type Recorder struct {
+	mu          sync.Mutex
+	file        *os.File
+	encoder     *json.Encoder
+	queryParams QueryParams
+}
+
+// Open は path に追記する Recorder を返す.
+// queryParams に含まれないクエリパラメタは、利用者が誤って付けたトークンなどを残さないよう、値を RedactedValue に置き換えて記録する.
+func Open(path string, queryParams QueryParams) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{file: file, encoder: json.NewEncoder(file), queryParams: queryParams}, nil
+}
+
+// SkipHash はレスポンスボディのハッシュ値を記録しないよう指定する. 日時などを含み、再生しても一致しないレスポンスに用いる.
+func SkipHash(c *gin.Context) {
+	c.Set(skipHashKey, true)
+}
+
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+func (r *Recorder) Write(entry Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.encoder.Encode(entry)
+}
+
+// Middleware はレスポンスのハッシュ値を計算し、リクエストを記録する.
+func (r *Recorder) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		writer := &hashWriter{ResponseWriter: c.Writer, hash: sha256.New()}
+		c.Writer = writer
+
+		c.Next()
+
+		// ルーティングに該当しないリクエストは、レスポンスボディが Middleware を経由せずに書き込まれるため記録しない.
+		if c.FullPath() == "" {
+			return
+		}
+
+		var allowed []string
+		if r.queryParams != nil {
+			allowed = r.queryParams(c.Request.Method, c.Request.URL.Path)
+		}
+		query, redacted := sanitizeQuery(c.Request.URL.RawQuery, allowed)
+
+		entry := Entry{
+			Method: c.Request.Method,
+			Path:   c.Request.URL.Path,
+			Query:  query,
+			Accept: c.GetHeader("Accept"),
+			Status: writer.Status(),
+		}
+		// 置き換えた値はエラーのレスポンスに含まれることがあるため、ボディは比較しない.
+		if !redacted && !c.GetBool(skipHashKey) {
+			entry.Hash = formatHash(writer.hash)
+		}
+		if err := r.Write(entry); err != nil {
+			c.Error(err)
+		}
+	}
+}
+
+// sanitizeQuery は allowed に含まれないクエリパラメタの値を RedactedValue に置き換える.
+// パラメタは名前の順に並べ替える. 値を置き換えた場合は true を返す.
+func sanitizeQuery(rawQuery string, allowed []string) (string, bool) {
+	if rawQuery == "" {
+		return "", false
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return RedactedValue, true
+	}
+
+	allowedNames := make(map[string]bool, len(allowed))
+	for _, name := range allowed {
+		allowedNames[name] = true
+	}
+	redacted := false
+	for name, values := range query {
+		if allowedNames[name] {
+			continue
+		}
+		for i := range values {
+			values[i] = RedactedValue
+		}
+		redacted = true
+	}
+	return query.Encode(), redacted
+}
+
+type hashWriter struct {
+	gin.ResponseWriter
+	hash hash.Hash
+}
+
+func (w *hashWriter) Write(b []byte) (int, error) {
+	w.hash.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *hashWriter) WriteString(s string) (int, error) {
+	w.hash.Write([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Hash はレスポンスボディのハッシュ値を記録と同じ形式で返す.
+func Hash(body []byte) string {
+	h := sha256.New()
+	h.Write(body)
+	return formatHash(h)
+}
+
+func formatHash(h hash.Hash) string {
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// Read は JSONL 形式の記録を読み込む. 空行は読み飛ばす.
+func Read(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,83 @@
+package recording
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSanitizeQuery(t *testing.T) {
+	tests := []struct {
+		name         string
+		rawQuery     string
+		allowed      []string
+		want         string
+		wantRedacted bool
+	}{
+		{"empty", "", []string{"limit"}, "", false},
+		{"allowed", "limit=10&offset=20", []string{"limit", "offset"}, "limit=10&offset=20", false},
+		{"sorted", "offset=20&limit=10", []string{"limit", "offset"}, "limit=10&offset=20", false},
+		{"repeated", "codes=551&codes=552", []string{"codes"}, "codes=551&codes=552", false},
+		{"not allowed", "limit=10&token=secret", []string{"limit"}, "limit=10&token=REDACTED", true},
+		{"no allowed parameters", "token=secret&token=other", nil, "token=REDACTED&token=REDACTED", true},
+		{"invalid escape", "token=%zz", []string{"limit"}, "REDACTED", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, redacted := sanitizeQuery(tt.rawQuery, tt.allowed)
+			if got != tt.want || redacted != tt.wantRedacted {
+				t.Errorf("sanitizeQuery() = %q, %v, want %q, %v", got, redacted, tt.want, tt.wantRedacted)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recorded.jsonl")
+	recorder, err := Open(path, func(method string, path string) []string {
+		return []string{"limit"}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(recorder.Middleware())
+	r.GET("/items", func(c *gin.Context) {
+		c.String(200, "items")
+	})
+	r.GET("/error", func(c *gin.Context) {
+		SkipHash(c)
+		c.String(500, "error")
+	})
+
+	for _, target := range []string{"/items?limit=10", "/items?limit=10&token=secret", "/error", "/unknown"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", target, nil))
+	}
+	recorder.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	entries, err := Read(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Entry{
+		{Method: "GET", Path: "/items", Query: "limit=10", Status: 200, Hash: Hash([]byte("items"))},
+		{Method: "GET", Path: "/items", Query: "limit=10&token=REDACTED", Status: 200},
+		{Method: "GET", Path: "/error", Status: 500},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("entries = %+v, want %+v", entries, want)
+	}
+}
@@ -0,0 +1,47 @@
+package recording
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Replay は記録したリクエストを baseURL の API に送り、ステータスコードとレスポンスボディのハッシュ値を返す.
+func Replay(client *http.Client, baseURL string, entry Entry) (int, string, error) {
+	u := strings.TrimSuffix(baseURL, "/") + entry.Path
+	if entry.Query != "" {
+		u += "?" + entry.Query
+	}
+	req, err := http.NewRequest(entry.Method, u, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	if entry.Accept != "" {
+		req.Header.Set("Accept", entry.Accept)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return 0, "", err
+	}
+	return res.StatusCode, Hash(body), nil
+}
+
+// Diff は再生したレスポンスと記録との差異を返す. 一致した場合は空のスライスを返す.
+// statusOnly の場合と、記録にハッシュ値がない場合はステータスコードのみ比較する.
+func (e Entry) Diff(status int, hash string, statusOnly bool) []string {
+	var diffs []string
+	if status != e.Status {
+		diffs = append(diffs, fmt.Sprintf("status %d, recorded %d", status, e.Status))
+	}
+	if !statusOnly && e.Hash != "" && hash != e.Hash {
+		diffs = append(diffs, "body differs")
+	}
+	return diffs
+}
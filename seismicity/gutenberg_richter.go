@@ -0,0 +1,126 @@
+// Package seismicity は地震のマグニチュードから、グーテンベルグ・リヒター則に基づく地震活動の統計量を求めるパッケージです.
+package seismicity
+
+import "math"
+
+// Bin はマグニチュードの階級ごとの地震の件数を表す.
+type Bin struct {
+	// Magnitude は階級の中心.
+	Magnitude float64
+	// Count は階級に含まれる件数.
+	Count int
+	// Cumulative は Magnitude 以上の件数.
+	Cumulative int
+}
+
+// BValue は最尤法で求めた b 値を表す.
+type BValue struct {
+	// B は Aki-Utsu の最尤推定値.
+	B float64
+	// Uncertainty は Shi and Bolt (1982) による b 値の標準誤差.
+	Uncertainty float64
+	// A は log10 N = A - B M の A. N は完全性のマグニチュード以上の件数.
+	A float64
+	// Count は推定に用いた、完全性のマグニチュード以上の件数.
+	Count int
+	// MeanMagnitude は推定に用いたマグニチュードの平均.
+	MeanMagnitude float64
+}
+
+// FrequencyMagnitudeDistribution はマグニチュードを bin の幅の階級に丸め、階級の昇順に件数と累積件数を返す.
+// 件数のない階級も、最小から最大の階級まで含める.
+func FrequencyMagnitudeDistribution(magnitudes []float64, bin float64) []Bin {
+	if len(magnitudes) == 0 {
+		return []Bin{}
+	}
+
+	counts := map[int]int{}
+	min, max := math.MaxInt32, math.MinInt32
+	for _, m := range magnitudes {
+		index := binIndex(m, bin)
+		counts[index]++
+		if index < min {
+			min = index
+		}
+		if index > max {
+			max = index
+		}
+	}
+
+	bins := make([]Bin, 0, max-min+1)
+	for index := min; index <= max; index++ {
+		bins = append(bins, Bin{Magnitude: binMagnitude(index, bin), Count: counts[index]})
+	}
+	cumulative := 0
+	for i := len(bins) - 1; i >= 0; i-- {
+		cumulative += bins[i].Count
+		bins[i].Cumulative = cumulative
+	}
+	return bins
+}
+
+// MaximumCurvature は最大曲率法 (MAXC) により、件数が最も多い階級を完全性のマグニチュードとして返す.
+// 件数が同じ階級がある場合は小さい方を返す.
+func MaximumCurvature(bins []Bin) (float64, bool) {
+	if len(bins) == 0 {
+		return 0, false
+	}
+	best := bins[0]
+	for _, b := range bins[1:] {
+		if b.Count > best.Count {
+			best = b
+		}
+	}
+	return best.Magnitude, true
+}
+
+// MaximumLikelihoodBValue は mc 以上のマグニチュードから Aki-Utsu の最尤法で b 値を推定する.
+// マグニチュードは bin の幅に丸められているものとし、 mc - bin/2 を下限として補正する.
+// 推定には 2 件以上が必要で、平均が下限と等しい場合も推定できない.
+func MaximumLikelihoodBValue(magnitudes []float64, mc float64, bin float64) (BValue, bool) {
+	var selected []float64
+	mcIndex := binIndex(mc, bin)
+	for _, m := range magnitudes {
+		if binIndex(m, bin) >= mcIndex {
+			selected = append(selected, binMagnitude(binIndex(m, bin), bin))
+		}
+	}
+	n := len(selected)
+	if n < 2 {
+		return BValue{}, false
+	}
+
+	sum := 0.0
+	for _, m := range selected {
+		sum += m
+	}
+	mean := sum / float64(n)
+	lower := binMagnitude(mcIndex, bin) - bin/2
+	if mean <= lower {
+		return BValue{}, false
+	}
+	b := math.Log10(math.E) / (mean - lower)
+
+	variance := 0.0
+	for _, m := range selected {
+		variance += (m - mean) * (m - mean)
+	}
+	uncertainty := 2.30 * b * b * math.Sqrt(variance/float64(n*(n-1)))
+
+	return BValue{
+		B:             b,
+		Uncertainty:   uncertainty,
+		A:             math.Log10(float64(n)) + b*binMagnitude(mcIndex, bin),
+		Count:         n,
+		MeanMagnitude: mean,
+	}, true
+}
+
+// binIndex は階級の番号を返す. 0.1 単位のマグニチュードが浮動小数点の誤差で隣の階級に入らないよう、わずかに加える.
+func binIndex(m float64, bin float64) int {
+	return int(math.Floor(m/bin + 0.5 + 1e-9))
+}
+
+func binMagnitude(index int, bin float64) float64 {
+	return math.Round(float64(index)*bin*1e6) / 1e6
+}
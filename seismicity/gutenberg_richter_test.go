@@ -0,0 +1,92 @@
+package seismicity
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFrequencyMagnitudeDistribution(t *testing.T) {
+	tests := []struct {
+		name       string
+		magnitudes []float64
+		bin        float64
+		want       []Bin
+	}{
+		{"empty", nil, 0.1, []Bin{}},
+		{"single bin", []float64{3.0}, 0.1, []Bin{{3.0, 1, 1}}},
+		{"empty bins between", []float64{2.0, 2.3, 2.0, 2.1}, 0.1, []Bin{{2.0, 2, 4}, {2.1, 1, 2}, {2.2, 0, 1}, {2.3, 1, 1}}},
+		{"rounded to the bin center", []float64{2.2, 2.3, 2.8}, 0.5, []Bin{{2.0, 1, 3}, {2.5, 1, 2}, {3.0, 1, 1}}},
+		{"floating point error", []float64{0.1 + 0.2, 0.3}, 0.1, []Bin{{0.3, 2, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FrequencyMagnitudeDistribution(tt.magnitudes, tt.bin); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FrequencyMagnitudeDistribution() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaximumCurvature(t *testing.T) {
+	tests := []struct {
+		name   string
+		bins   []Bin
+		want   float64
+		wantOK bool
+	}{
+		{"empty", nil, 0, false},
+		{"single bin", []Bin{{3.0, 1, 1}}, 3.0, true},
+		{"largest count", []Bin{{2.0, 2, 9}, {2.1, 5, 7}, {2.2, 2, 2}}, 2.1, true},
+		{"smaller of ties", []Bin{{2.0, 2, 8}, {2.1, 3, 6}, {2.2, 3, 3}}, 2.1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := MaximumCurvature(tt.bins)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("MaximumCurvature() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMaximumLikelihoodBValue(t *testing.T) {
+	// b = log10(e) / (平均 - (Mc - bin/2)) 、標準誤差 = 2.30 b^2 sqrt(Σ(M - 平均)^2 / (n (n - 1))) 、 a = log10(n) + b Mc.
+	tests := []struct {
+		name       string
+		magnitudes []float64
+		mc         float64
+		want       BValue
+		wantOK     bool
+	}{
+		{"all", []float64{2.0, 2.0, 2.1, 2.3}, 2.0,
+			BValue{B: 2.895296546, Uncertainty: 1.363323569, A: 6.392653083, Count: 4, MeanMagnitude: 2.1}, true},
+		{"above mc", []float64{2.0, 2.0, 2.1, 2.3}, 2.1,
+			BValue{B: 2.895296546, Uncertainty: 1.928030681, A: 6.381152742, Count: 2, MeanMagnitude: 2.2}, true},
+		{"empty", nil, 2.0, BValue{}, false},
+		{"single magnitude above mc", []float64{2.0, 2.0, 2.3}, 2.2, BValue{}, false},
+		{"all below mc", []float64{2.0, 2.0, 2.1, 2.3}, 3.0, BValue{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := MaximumLikelihoodBValue(tt.magnitudes, tt.mc, 0.1)
+			if ok != tt.wantOK {
+				t.Fatalf("MaximumLikelihoodBValue() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got.Count != tt.want.Count ||
+				!almostEqual(got.B, tt.want.B) ||
+				!almostEqual(got.Uncertainty, tt.want.Uncertainty) ||
+				!almostEqual(got.A, tt.want.A) ||
+				!almostEqual(got.MeanMagnitude, tt.want.MeanMagnitude) {
+				t.Errorf("MaximumLikelihoodBValue() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func almostEqual(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
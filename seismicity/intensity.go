@@ -0,0 +1,97 @@
+package seismicity
+
+import "math"
+
+// 地震のタイプ. 最大速度の距離減衰式の係数 d を選ぶのに用いる.
+const (
+	Crustal    = "crustal"
+	Interplate = "interplate"
+	Intraplate = "intraplate"
+)
+
+// earthRadius は地球の平均半径 (km).
+const earthRadius = 6371.0
+
+// maxMomentMagnitude は Si and Midorikawa (1999) の距離減衰式を適用するモーメントマグニチュードの上限.
+// これより大きい地震は上限の値で求めるため、震度を小さく見積もる.
+const maxMomentMagnitude = 8.3
+
+// maxDepth は距離減衰式の深さの項に用いる震源の深さ (km) の上限.
+const maxDepth = 100.0
+
+// HypocentralDistance は震央と地点の緯度・経度 (度) 、震源の深さ (km) から震源距離 (km) を求める.
+func HypocentralDistance(latitude, longitude, depth, siteLatitude, siteLongitude float64) float64 {
+	phi1 := latitude * math.Pi / 180
+	phi2 := siteLatitude * math.Pi / 180
+	dPhi := phi2 - phi1
+	dLambda := (siteLongitude - longitude) * math.Pi / 180
+
+	h := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	epicentral := 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+	return math.Sqrt(epicentral*epicentral + depth*depth)
+}
+
+// BedrockVelocity は Si and Midorikawa (1999) の距離減衰式で、工学的基盤 (Vs = 600 m/s) の最大速度 (cm/s) を求める.
+// 断層最短距離の代わりに震源距離 distance (km) を用いる点震源の近似で、断層の広がりは考慮しない.
+func BedrockVelocity(mw, depth, distance float64, faultType string) float64 {
+	mw = math.Min(mw, maxMomentMagnitude)
+	depth = math.Min(math.Max(depth, 0), maxDepth)
+
+	d := 0.0
+	switch faultType {
+	case Interplate:
+		d = -0.02
+	case Intraplate:
+		d = 0.12
+	}
+
+	logPGV := 0.58*mw + 0.0038*depth + d - 1.29 - math.Log10(distance+0.0028*math.Pow(10, 0.5*mw)) - 0.002*distance
+	return math.Pow(10, logPGV)
+}
+
+// SurfaceAmplification は Midorikawa et al. (1994) の式で、地表から深さ 30 m までの平均 S 波速度 avs30 (m/s) から
+// 工学的基盤に対する地表の最大速度の増幅率を求める.
+func SurfaceAmplification(avs30 float64) float64 {
+	return math.Pow(10, 1.83-0.66*math.Log10(avs30))
+}
+
+// lowIntensityLimit は InstrumentalIntensity で小さい揺れの式を用いる計測震度の上限.
+const lowIntensityLimit = 4.0
+
+// InstrumentalIntensity は翠川ほか (1999) の式で、地表の最大速度 (cm/s) から計測震度を求める.
+// 計測震度 4 未満では I = 2.165 + 2.262 log PGV 、それ以上では I = 2.68 + 1.72 log PGV を用いる.
+// 1 つの式では小さい揺れを過大に見積もるため、 2 つに分ける.
+// 気象庁と同じく、小数第 3 位を四捨五入して小数第 2 位を切り捨てた値を返す.
+func InstrumentalIntensity(pgv float64) float64 {
+	intensity := 2.165 + 2.262*math.Log10(pgv)
+	if intensity >= lowIntensityLimit {
+		intensity = 2.68 + 1.72*math.Log10(pgv)
+	}
+	return math.Floor(math.Round(intensity*100)/10) / 10
+}
+
+// Scale は計測震度を震度階級に変換する. 値は地震情報の震度と同じく 10 (震度1) 〜 70 (震度7) で、震度0は 0 を返す.
+func Scale(intensity float64) int {
+	switch {
+	case intensity < 0.5:
+		return 0
+	case intensity < 1.5:
+		return 10
+	case intensity < 2.5:
+		return 20
+	case intensity < 3.5:
+		return 30
+	case intensity < 4.5:
+		return 40
+	case intensity < 5.0:
+		return 45
+	case intensity < 5.5:
+		return 50
+	case intensity < 6.0:
+		return 55
+	case intensity < 6.5:
+		return 60
+	default:
+		return 70
+	}
+}
@@ -0,0 +1,89 @@
+package seismicity
+
+import "testing"
+
+func TestBedrockVelocity(t *testing.T) {
+	// log PGV = 0.58 Mw + 0.0038 D + d - 1.29 - log(X + 0.0028 * 10^(0.5 Mw)) - 0.002 X (Si and Midorikawa, 1999).
+	tests := []struct {
+		name      string
+		mw        float64
+		depth     float64
+		distance  float64
+		faultType string
+		want      float64
+	}{
+		{"crustal", 7.0, 10, 50, Crustal, 8.674036191},
+		{"interplate", 7.0, 10, 50, Interplate, 8.283640253},
+		{"intraplate", 7.0, 10, 50, Intraplate, 11.434606659},
+		{"shallow", 6.0, 0, 20, Crustal, 6.195340108},
+		{"negative depth", 6.0, -5, 20, Crustal, 6.195340108},
+		{"upper limits", 8.3, 100, 100, Crustal, 36.246567652},
+		{"above the upper limits", 9.0, 150, 100, Crustal, 36.246567652},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BedrockVelocity(tt.mw, tt.depth, tt.distance, tt.faultType); !almostEqual(got, tt.want) {
+				t.Errorf("BedrockVelocity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstrumentalIntensity(t *testing.T) {
+	// I = 2.165 + 2.262 log PGV (I < 4) 、 I = 2.68 + 1.72 log PGV (I >= 4) (翠川ほか, 1999).
+	// 2 つの式は PGV = 6.47 cm/s 付近で切り替わる.
+	tests := []struct {
+		pgv  float64
+		want float64
+	}{
+		{0.5, 1.4},  // 1.484
+		{1, 2.1},    // 2.165
+		{6.4, 3.9},  // 3.989
+		{6.47, 4.0}, // 3.9993 を小数第 3 位で四捨五入する
+		{7, 4.1},    // 2.68 + 1.72 log 7 = 4.134 (1 つ目の式では 4.077)
+		{10, 4.4},   // 4.4
+		{20, 4.9},   // 4.918 (1 つ目の式では 5.108)
+		{100, 6.1},  // 6.12
+	}
+
+	for _, tt := range tests {
+		if got := InstrumentalIntensity(tt.pgv); got != tt.want {
+			t.Errorf("InstrumentalIntensity(%v) = %v, want %v", tt.pgv, got, tt.want)
+		}
+	}
+}
+
+func TestScale(t *testing.T) {
+	tests := []struct {
+		intensity float64
+		want      int
+	}{
+		{-0.5, 0},
+		{0.4, 0},
+		{0.5, 10},
+		{1.4, 10},
+		{1.5, 20},
+		{2.4, 20},
+		{2.5, 30},
+		{3.4, 30},
+		{3.5, 40},
+		{4.4, 40},
+		{4.5, 45},
+		{4.9, 45},
+		{5.0, 50},
+		{5.4, 50},
+		{5.5, 55},
+		{5.9, 55},
+		{6.0, 60},
+		{6.4, 60},
+		{6.5, 70},
+		{7.2, 70},
+	}
+
+	for _, tt := range tests {
+		if got := Scale(tt.intensity); got != tt.want {
+			t.Errorf("Scale(%v) = %v, want %v", tt.intensity, got, tt.want)
+		}
+	}
+}
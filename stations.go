@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type StationParam struct {
+	Offset     int64  `form:"offset"`
+	Limit      int64  `form:"limit"`
+	Prefecture string `form:"prefecture"`
+	Name       string `form:"name"`
+	IsArea     *bool  `form:"is_area"`
+	Lang       string `form:"lang"`
+}
+
+// matches は震度観測点が条件に合うかどうかを返す. 名称は部分一致とする.
+func (p *StationParam) matches(s station) bool {
+	if p.Prefecture != "" && s.Prefecture != p.Prefecture {
+		return false
+	}
+	if p.Name != "" && !strings.Contains(s.Name, p.Name) {
+		return false
+	}
+	if p.IsArea != nil && s.IsArea != *p.IsArea {
+		return false
+	}
+	return true
+}
+
+// station は震度観測点 (震度速報では区域) と、震度を観測した地震の件数・発生日時.
+type station struct {
+	Name         string `bson:"name" json:"name"`
+	Prefecture   string `bson:"prefecture" json:"prefecture"`
+	PrefectureEn string `bson:"-" json:"prefecture_en,omitempty"`
+	IsArea       bool   `bson:"is_area" json:"is_area"`
+	Count        int    `bson:"count" json:"count"`
+	FirstTime    string `bson:"first_time" json:"first_time"`
+	LastTime     string `bson:"last_time" json:"last_time"`
+}
+
+// stationCatalogTTL は震度観測点の一覧を作り直す間隔. Config の STATION_CATALOG_TTL で変更できる.
+var stationCatalogTTL = 10 * time.Minute
+
+// stationCatalog は震度観測点の一覧のキャッシュ.
+// 一覧は地震情報の全件から作るため、リクエストごとには集計せず、 stationCatalogTTL を過ぎてから最初のリクエストで作り直す.
+var stationCatalog struct {
+	sync.Mutex
+	stations  []station
+	updatedAt time.Time
+}
+
+func searchStations(c *gin.Context) {
+	var stationParam StationParam
+	if !bindQuery(c, &stationParam) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	limit := stationParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	catalog, err := findStationCatalog(ctx)
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	stations := make([]station, 0)
+	skipped := int64(0)
+	for _, s := range catalog {
+		if int64(len(stations)) >= limit {
+			break
+		}
+		if !stationParam.matches(s) {
+			continue
+		}
+		if skipped < stationParam.Offset {
+			skipped++
+			continue
+		}
+		if stationParam.Lang == langEnglish {
+			s.PrefectureEn, _ = userquake.EnglishPrefecture(s.Prefecture)
+		}
+		stations = append(stations, s)
+	}
+
+	respond(c, 200, stations, &pb.Stations{})
+}
+
+// findStationCatalog は震度観測点の一覧を返す. キャッシュが古い場合は作り直す.
+// 作り直しに失敗した場合、以前の一覧があればそれを返す.
+func findStationCatalog(ctx context.Context) ([]station, error) {
+	stationCatalog.Lock()
+	defer stationCatalog.Unlock()
+
+	if stationCatalog.stations != nil && time.Since(stationCatalog.updatedAt) < stationCatalogTTL {
+		return stationCatalog.stations, nil
+	}
+
+	cur, err := jmaCollection.Aggregate(ctx, stationsPipeline(), aggregateOptions())
+	if err == nil {
+		defer cur.Close(ctx)
+		stations := make([]station, 0)
+		if err = cur.All(ctx, &stations); err == nil {
+			stationCatalog.stations = stations
+			stationCatalog.updatedAt = time.Now()
+			return stations, nil
+		}
+	}
+	if stationCatalog.stations != nil {
+		log.Printf("station catalog refresh error: %v\n", err)
+		return stationCatalog.stations, nil
+	}
+	return nil, err
+}
+
+// stationsPipeline は地震情報の各地の震度から震度観測点 (震度速報では区域) の一覧を作る集計パイプラインを返す.
+// 観測点は都道府県・名称・区域名かどうかの組で区別し、震度を観測した地震の件数と、最初と最後の地震の発生日時を求める.
+// 全件を $unwind するため、先に観測点と発生日時のみに絞る.
+func stationsPipeline() []bson.D {
+	return []bson.D{
+		{{"$match", bson.D{{"code", 551}}}},
+		{{"$project", bson.D{{"_id", 0}, {"points.pref", 1}, {"points.addr", 1}, {"points.isArea", 1}, {"earthquake.time", 1}}}},
+		{{"$unwind", "$points"}},
+		{{"$group", bson.D{
+			{"_id", bson.D{{"pref", "$points.pref"}, {"addr", "$points.addr"}, {"isArea", "$points.isArea"}, {"time", "$earthquake.time"}}},
+		}}},
+		{{"$group", bson.D{
+			{"_id", bson.D{{"pref", "$_id.pref"}, {"addr", "$_id.addr"}, {"isArea", "$_id.isArea"}}},
+			{"count", bson.D{{"$sum", 1}}},
+			{"first_time", bson.D{{"$min", "$_id.time"}}},
+			{"last_time", bson.D{{"$max", "$_id.time"}}},
+		}}},
+		{{"$sort", bson.D{{"_id.pref", 1}, {"_id.isArea", 1}, {"_id.addr", 1}}}},
+		{{"$project", bson.D{
+			{"_id", 0},
+			{"name", "$_id.addr"},
+			{"prefecture", "$_id.pref"},
+			{"is_area", "$_id.isArea"},
+			{"count", 1},
+			{"first_time", 1},
+			{"last_time", 1},
+		}}},
+	}
+}
+
+type StationObservationParam struct {
+	Offset     int64  `form:"offset"`
+	Limit      int64  `form:"limit"`
+	SinceDate  string `form:"since_date"`
+	UntilDate  string `form:"until_date"`
+	Prefecture string `form:"prefecture"`
+	MinScale   int64  `form:"min_scale"`
+	Lang       string `form:"lang"`
+}
+
+func (p *StationObservationParam) validateCrossFields() []InvalidParam {
+	quakeParam := QuakeParam{SinceDate: p.SinceDate, UntilDate: p.UntilDate}
+	return quakeParam.validateCrossFields()
+}
+
+func searchStationObservations(c *gin.Context) {
+	var observationParam StationObservationParam
+	if !bindQuery(c, &observationParam) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	limit := observationParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	// 震度の下限は、地震ごとに観測点を含む最新の情報を選んでから適用する.
+	pointFilters := bson.D{{"addr", c.Param("name")}}
+	if observationParam.Prefecture != "" {
+		pointFilters = append(pointFilters, bson.E{"pref", observationParam.Prefecture})
+	}
+	filters := append(quakeFilters(QuakeParam{SinceDate: observationParam.SinceDate, UntilDate: observationParam.UntilDate}),
+		bson.E{"points", bson.D{{"$elemMatch", pointFilters}}})
+
+	pipeline := quakeEventStages(filters, "issue.type", "earthquake", "points")
+	if observationParam.MinScale != 0 {
+		scaleFilters := append(append(bson.D{}, pointFilters...), bson.E{"scale", bson.D{{"$gte", observationParam.MinScale}}})
+		pipeline = append(pipeline, bson.D{{"$match", bson.D{{"points", bson.D{{"$elemMatch", scaleFilters}}}}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{"$sort", bson.D{{"earthquake.time", -1}}}},
+		bson.D{{"$skip", observationParam.Offset}},
+		bson.D{{"$limit", limit}},
+	)
+	cur, err := jmaCollection.Aggregate(ctx, pipeline, aggregateOptions())
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	var records []primitive.M
+	if err := cur.All(ctx, &records); err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	observations := make([]primitive.M, 0, len(records))
+	for _, record := range records {
+		observation := stationObservation(record, c.Param("name"), observationParam.Prefecture)
+		if observationParam.Lang == langEnglish {
+			localizeStationObservation(observation)
+		}
+		observations = append(observations, observation)
+	}
+
+	respond(c, 200, observations, &pb.StationObservations{})
+}
+
+// stationObservation は地震情報から、震度観測点で観測した震度と震源を取り出す.
+// 同じ名称の観測点が複数の都道府県にあり、都道府県を指定していない場合は、最も大きい震度を返す.
+func stationObservation(record primitive.M, name string, prefecture string) primitive.M {
+	issue := recordMap(record, "issue")
+	earthquake := recordMap(record, "earthquake")
+
+	observation := primitive.M{
+		"id":        recordID(record),
+		"type":      recordString(issue, "type"),
+		"time":      recordString(earthquake, "time"),
+		"max_scale": -1,
+		"scale":     -1,
+	}
+	if maxScale, ok := recordInt(earthquake, "maxScale"); ok {
+		observation["max_scale"] = maxScale
+	}
+
+	for _, point := range recordArray(record, "points") {
+		if recordString(point, "addr") != name || (prefecture != "" && recordString(point, "pref") != prefecture) {
+			continue
+		}
+		scale, ok := recordInt(point, "scale")
+		if !ok || scale <= observation["scale"].(int) {
+			continue
+		}
+		observation["scale"] = scale
+		observation["prefecture"] = recordString(point, "pref")
+		observation["is_area"], _ = point["isArea"].(bool)
+	}
+
+	if hypocenter := recordMap(earthquake, "hypocenter"); hypocenter != nil {
+		if name := recordString(hypocenter, "name"); name != "" {
+			observation["hypocenter"] = name
+		}
+		if latitude, ok := recordFloat(hypocenter, "latitude"); ok && latitude > -200 {
+			observation["latitude"] = latitude
+		}
+		if longitude, ok := recordFloat(hypocenter, "longitude"); ok && longitude > -200 {
+			observation["longitude"] = longitude
+		}
+		if depth, ok := recordFloat(hypocenter, "depth"); ok && depth >= 0 {
+			observation["depth"] = depth
+		}
+		if magnitude, ok := recordFloat(hypocenter, "magnitude"); ok && magnitude >= 0 {
+			observation["magnitude"] = magnitude
+		}
+	}
+	return observation
+}
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/seismicity"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// QuakeStatsParam は QuakeParam の絞り込み条件に、集計の単位を加えたもの.
+type QuakeStatsParam struct {
+	QuakeType    string   `form:"quake_type"`
+	MinScale     int64    `form:"min_scale"`
+	MaxScale     int64    `form:"max_scale"`
+	MinMagnitude float64  `form:"min_magnitude"`
+	MaxMagnitude float64  `form:"max_magnitude"`
+	SinceDate    string   `form:"since_date"`
+	UntilDate    string   `form:"until_date"`
+	Prefectures  []string `form:"prefectures[]"`
+	Interval     string   `form:"interval"`
+	MagnitudeBin float64  `form:"magnitude_bin"`
+	Lang         string   `form:"lang"`
+}
+
+func (p *QuakeStatsParam) quakeParam() QuakeParam {
+	return QuakeParam{
+		QuakeType:    p.QuakeType,
+		MinScale:     p.MinScale,
+		MaxScale:     p.MaxScale,
+		MinMagnitude: p.MinMagnitude,
+		MaxMagnitude: p.MaxMagnitude,
+		SinceDate:    p.SinceDate,
+		UntilDate:    p.UntilDate,
+		Prefectures:  p.Prefectures,
+	}
+}
+
+func (p *QuakeStatsParam) validateCrossFields() []InvalidParam {
+	quakeParam := p.quakeParam()
+	return quakeParam.validateCrossFields()
+}
+
+func getQuakeStats(c *gin.Context) {
+	var statsParam QuakeStatsParam
+	if !bindQuery(c, &statsParam) {
+		return
+	}
+	interval := statsParam.Interval
+	if interval == "" {
+		interval = "month"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	filters := quakeFilters(statsParam.quakeParam())
+	stats, err := aggregateQuakeStats(ctx, filters, interval)
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	if statsParam.MagnitudeBin != 0.0 {
+		bins, err := aggregateMagnitudeBins(ctx, filters, interval, statsParam.MagnitudeBin)
+		if err != nil {
+			log.Printf("aggregate error: %v\n", err)
+			respondProblem(c, 500, "database error")
+			return
+		}
+		for _, item := range stats {
+			magnitudes, ok := bins[recordString(item, "period")]
+			if !ok {
+				magnitudes = map[string]int{}
+			}
+			item["magnitudes"] = magnitudes
+		}
+	}
+	if statsParam.Lang == langEnglish {
+		for _, item := range stats {
+			item["scales_en"] = englishScaleCounts(item["scales"])
+		}
+	}
+
+	respond(c, 200, stats, &pb.QuakeStatsList{})
+}
+
+// quakeEventStages は絞り込んだ地震情報を、発生日時ごとに最新の情報 1 件にまとめる.
+// 1 つの地震に対して震度速報や震源・震度情報など複数の情報が発表されるため、地震の件数として数えるために用いる.
+// fields を指定した場合は、まとめる前にそのフィールドと、並べ替え・まとめに用いる日時のみに絞り、 $group で保持する量を減らす.
+func quakeEventStages(filters bson.D, fields ...string) []bson.D {
+	stages := []bson.D{
+		{{"$match", filters}},
+		{{"$sort", bson.D{{"time", -1}}}},
+	}
+	if len(fields) > 0 {
+		stages = append(stages, bson.D{{"$project", fieldProjection(append([]string{"time", "earthquake.time"}, fields...))}})
+	}
+	return append(stages,
+		bson.D{{"$group", bson.D{{"_id", "$earthquake.time"}, {"record", bson.D{{"$first", "$$ROOT"}}}}}},
+		bson.D{{"$replaceRoot", bson.D{{"newRoot", "$record"}}}},
+	)
+}
+
+// fieldProjection は fields のみを含める $project の指定を返す.
+// "earthquake" と "earthquake.time" のように重なるフィールドは、 MongoDB がエラーとするため上位のフィールドのみとする.
+func fieldProjection(fields []string) bson.D {
+	projection := bson.D{}
+	included := map[string]bool{}
+	for _, field := range fields {
+		covered := included[field]
+		for _, other := range fields {
+			if strings.HasPrefix(field, other+".") {
+				covered = true
+			}
+		}
+		if !covered {
+			projection = append(projection, bson.E{field, 1})
+			included[field] = true
+		}
+	}
+	return projection
+}
+
+// aggregateOptions は集計のオプション. 期間を絞らない集計は $group や $sort のメモリの上限を超えることがあるため、一時ファイルの使用を許可する.
+func aggregateOptions() *options.AggregateOptions {
+	return options.Aggregate().SetAllowDiskUse(true)
+}
+
+// periodExpression は発生日時を、集計の単位の初日 (`2006/01/02` 形式) に変換する式を返す. 週は月曜日から始まる.
+func periodExpression(interval string) interface{} {
+	switch interval {
+	case "day":
+		return bson.D{{"$substrBytes", bson.A{"$earthquake.time", 0, 10}}}
+	case "week":
+		date := bson.D{{"$dateFromString", bson.D{{"dateString", "$earthquake.time"}, {"format", "%Y/%m/%d %H:%M:%S"}, {"timezone", "+09:00"}, {"onError", nil}}}}
+		dayOfWeek := bson.D{{"$isoDayOfWeek", bson.D{{"date", date}, {"timezone", "+09:00"}}}}
+		monday := bson.D{{"$subtract", bson.A{date, bson.D{{"$multiply", bson.A{bson.D{{"$subtract", bson.A{dayOfWeek, 1}}}, 24 * 60 * 60 * 1000}}}}}}
+		return bson.D{{"$dateToString", bson.D{{"format", "%Y/%m/%d"}, {"date", monday}, {"timezone", "+09:00"}}}}
+	case "year":
+		return bson.D{{"$concat", bson.A{bson.D{{"$substrBytes", bson.A{"$earthquake.time", 0, 4}}}, "/01/01"}}}
+	default:
+		return bson.D{{"$concat", bson.A{bson.D{{"$substrBytes", bson.A{"$earthquake.time", 0, 7}}}, "/01"}}}
+	}
+}
+
+// aggregateQuakeStats は地震の件数を、集計の単位ごと・最大震度ごとに数える. 最大震度のキーは震度の値 ("-1" は震度情報なし) .
+func aggregateQuakeStats(ctx context.Context, filters bson.D, interval string) ([]primitive.M, error) {
+	pipeline := append(quakeEventStages(filters, "earthquake.maxScale"),
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"period", periodExpression(interval)}, {"scale", bson.D{{"$toString", "$earthquake.maxScale"}}}}},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$_id.period"},
+			{"count", bson.D{{"$sum", "$count"}}},
+			{"scales", bson.D{{"$push", bson.D{{"k", "$_id.scale"}, {"v", "$count"}}}}},
+		}}},
+		bson.D{{"$project", bson.D{{"_id", 0}, {"period", "$_id"}, {"count", 1}, {"scales", bson.D{{"$arrayToObject", "$scales"}}}}}},
+		bson.D{{"$sort", bson.D{{"period", 1}}}},
+	)
+
+	cur, err := jmaCollection.Aggregate(ctx, pipeline, aggregateOptions())
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	stats := make([]primitive.M, 0)
+	if err := cur.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// aggregateMagnitudeBins は震源情報のある地震の件数を、集計の単位ごと・マグニチュードの階級ごとに数える.
+// 階級のキーは階級の下限で、例えば bin が 0.5 の場合 M3.7 は "3.5" に数える.
+func aggregateMagnitudeBins(ctx context.Context, filters bson.D, interval string, bin float64) (map[string]map[string]int, error) {
+	// 3.0 / 0.1 が 29.999... となるような誤差で下の階級に入らないよう、わずかに加える.
+	index := bson.D{{"$floor", bson.D{{"$add", bson.A{bson.D{{"$divide", bson.A{"$earthquake.hypocenter.magnitude", bin}}}, 1e-9}}}}}
+	pipeline := append(quakeEventStages(filters, "earthquake.hypocenter.magnitude"),
+		bson.D{{"$match", bson.D{{"earthquake.hypocenter.magnitude", bson.D{{"$gte", 0}}}}}},
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"period", periodExpression(interval)}, {"index", index}}},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+	)
+
+	cur, err := jmaCollection.Aggregate(ctx, pipeline, aggregateOptions())
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []bson.M
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	binText := strconv.FormatFloat(bin, 'f', -1, 64)
+	decimals := 0
+	if i := strings.Index(binText, "."); i >= 0 {
+		decimals = len(binText) - i - 1
+	}
+	if decimals == 0 {
+		decimals = 1
+	}
+
+	bins := map[string]map[string]int{}
+	for _, result := range results {
+		id := recordMap(result, "_id")
+		period := recordString(id, "period")
+		index, _ := recordFloat(id, "index")
+		count, _ := recordInt(result, "count")
+
+		if _, ok := bins[period]; !ok {
+			bins[period] = map[string]int{}
+		}
+		lower := math.Round(index*bin*math.Pow10(decimals)) / math.Pow10(decimals)
+		bins[period][strconv.FormatFloat(lower, 'f', decimals, 64)] += count
+	}
+	return bins, nil
+}
+
+type PrefectureStatsParam struct {
+	SinceDate  string `form:"since_date"`
+	UntilDate  string `form:"until_date"`
+	Prefecture string `form:"prefecture"`
+	MinScale   int64  `form:"min_scale"`
+	Lang       string `form:"lang"`
+}
+
+func (p *PrefectureStatsParam) validateCrossFields() []InvalidParam {
+	quakeParam := QuakeParam{SinceDate: p.SinceDate, UntilDate: p.UntilDate}
+	return quakeParam.validateCrossFields()
+}
+
+func getPrefectureStats(c *gin.Context) {
+	var statsParam PrefectureStatsParam
+	if !bindQuery(c, &statsParam) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cur, err := jmaCollection.Aggregate(ctx, prefectureStatsPipeline(statsParam), aggregateOptions())
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	stats := make([]primitive.M, 0)
+	if err := cur.All(ctx, &stats); err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	if statsParam.Lang == langEnglish {
+		for _, item := range stats {
+			if name, ok := userquake.EnglishPrefecture(recordString(item, "prefecture")); ok {
+				item["prefecture_en"] = name
+			}
+			item["scales_en"] = englishScaleCounts(item["scales"])
+		}
+	}
+
+	respond(c, 200, stats, &pb.PrefectureStatsList{})
+}
+
+// prefectureStatsPipeline は都道府県ごとに、地震の件数を都道府県内で観測した最大の震度別に数える集計パイプラインを返す.
+// 地震ごとに最後に発表された各地の震度に関する情報 (DetailScale) のみを用いる.
+func prefectureStatsPipeline(statsParam PrefectureStatsParam) []bson.D {
+	filters := quakeFilters(QuakeParam{QuakeType: "DetailScale", SinceDate: statsParam.SinceDate, UntilDate: statsParam.UntilDate})
+
+	pipeline := append(quakeEventStages(filters, "points.pref", "points.scale"), bson.D{{"$unwind", "$points"}})
+	if statsParam.Prefecture != "" {
+		pipeline = append(pipeline, bson.D{{"$match", bson.D{{"points.pref", statsParam.Prefecture}}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"time", "$earthquake.time"}, {"pref", "$points.pref"}}},
+			{"scale", bson.D{{"$max", "$points.scale"}}},
+		}}},
+	)
+	if statsParam.MinScale != 0 {
+		pipeline = append(pipeline, bson.D{{"$match", bson.D{{"scale", bson.D{{"$gte", statsParam.MinScale}}}}}})
+	}
+	return append(pipeline,
+		bson.D{{"$group", bson.D{
+			{"_id", bson.D{{"pref", "$_id.pref"}, {"scale", bson.D{{"$toString", "$scale"}}}}},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+		bson.D{{"$group", bson.D{
+			{"_id", "$_id.pref"},
+			{"count", bson.D{{"$sum", "$count"}}},
+			{"scales", bson.D{{"$push", bson.D{{"k", "$_id.scale"}, {"v", "$count"}}}}},
+		}}},
+		bson.D{{"$project", bson.D{{"_id", 0}, {"prefecture", "$_id"}, {"count", 1}, {"scales", bson.D{{"$arrayToObject", "$scales"}}}}}},
+		bson.D{{"$sort", bson.D{{"count", -1}, {"prefecture", 1}}}},
+	)
+}
+
+// GutenbergRichterParam は QuakeParam の絞り込み条件に、震源の範囲と解析の条件を加えたもの.
+type GutenbergRichterParam struct {
+	QuakeType    string   `form:"quake_type"`
+	MinScale     int64    `form:"min_scale"`
+	MaxScale     int64    `form:"max_scale"`
+	MinMagnitude float64  `form:"min_magnitude"`
+	MaxMagnitude float64  `form:"max_magnitude"`
+	SinceDate    string   `form:"since_date"`
+	UntilDate    string   `form:"until_date"`
+	Prefectures  []string `form:"prefectures[]"`
+	MinLatitude  *float64 `form:"min_latitude"`
+	MaxLatitude  *float64 `form:"max_latitude"`
+	MinLongitude *float64 `form:"min_longitude"`
+	MaxLongitude *float64 `form:"max_longitude"`
+	MinDepth     *float64 `form:"min_depth"`
+	MaxDepth     *float64 `form:"max_depth"`
+	Bin          float64  `form:"bin"`
+	Mc           *float64 `form:"mc"`
+	Lang         string   `form:"lang"`
+}
+
+func (p *GutenbergRichterParam) quakeParam() QuakeParam {
+	return QuakeParam{
+		QuakeType:    p.QuakeType,
+		MinScale:     p.MinScale,
+		MaxScale:     p.MaxScale,
+		MinMagnitude: p.MinMagnitude,
+		MaxMagnitude: p.MaxMagnitude,
+		SinceDate:    p.SinceDate,
+		UntilDate:    p.UntilDate,
+		Prefectures:  p.Prefectures,
+	}
+}
+
+func (p *GutenbergRichterParam) validateCrossFields() []InvalidParam {
+	quakeParam := p.quakeParam()
+	invalidParams := quakeParam.validateCrossFields()
+	if p.MinLatitude != nil && p.MaxLatitude != nil && *p.MinLatitude > *p.MaxLatitude {
+		invalidParams = append(invalidParams, orderedFieldError("min_latitude", "max_latitude", *p.MinLatitude))
+	}
+	if p.MinLongitude != nil && p.MaxLongitude != nil && *p.MinLongitude > *p.MaxLongitude {
+		invalidParams = append(invalidParams, orderedFieldError("min_longitude", "max_longitude", *p.MinLongitude))
+	}
+	if p.MinDepth != nil && p.MaxDepth != nil && *p.MinDepth > *p.MaxDepth {
+		invalidParams = append(invalidParams, orderedFieldError("min_depth", "max_depth", *p.MinDepth))
+	}
+	return invalidParams
+}
+
+func getGutenbergRichter(c *gin.Context) {
+	var grParam GutenbergRichterParam
+	if !bindQuery(c, &grParam) {
+		return
+	}
+	bin := grParam.Bin
+	if bin == 0.0 {
+		bin = 0.1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	magnitudes, err := findMagnitudes(ctx, gutenbergRichterFilters(grParam))
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	bins := seismicity.FrequencyMagnitudeDistribution(magnitudes, bin)
+	distribution := make([]primitive.M, 0, len(bins))
+	for _, b := range bins {
+		distribution = append(distribution, primitive.M{"magnitude": b.Magnitude, "count": b.Count, "cumulative": b.Cumulative})
+	}
+	result := primitive.M{
+		"count":        len(magnitudes),
+		"bin":          bin,
+		"distribution": distribution,
+	}
+
+	mc, ok := seismicity.MaximumCurvature(bins)
+	method := "maxc"
+	if grParam.Mc != nil {
+		mc, ok, method = *grParam.Mc, true, "fixed"
+	}
+	if ok {
+		result["mc"] = primitive.M{"magnitude": mc, "method": method}
+		if b, ok := seismicity.MaximumLikelihoodBValue(magnitudes, mc, bin); ok {
+			result["b_value"] = primitive.M{
+				"b":              math.Round(b.B*1000) / 1000,
+				"uncertainty":    math.Round(b.Uncertainty*1000) / 1000,
+				"a":              math.Round(b.A*1000) / 1000,
+				"count":          b.Count,
+				"mean_magnitude": math.Round(b.MeanMagnitude*1000) / 1000,
+			}
+		}
+	}
+
+	respond(c, 200, result, &pb.GutenbergRichter{})
+}
+
+// gutenbergRichterFilters は地震情報の絞り込み条件に、震源の範囲と、震源・マグニチュードのある情報に限る条件を加える.
+func gutenbergRichterFilters(grParam GutenbergRichterParam) bson.D {
+	filters := quakeFilters(grParam.quakeParam())
+	filters = append(filters,
+		bson.E{"earthquake.hypocenter.latitude", bson.D{{"$gt", -200}}},
+		bson.E{"earthquake.hypocenter.magnitude", bson.D{{"$gte", 0}}},
+	)
+	if grParam.MinLatitude != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.latitude", bson.D{{"$gte", *grParam.MinLatitude}}})
+	}
+	if grParam.MaxLatitude != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.latitude", bson.D{{"$lte", *grParam.MaxLatitude}}})
+	}
+	if grParam.MinLongitude != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.longitude", bson.D{{"$gte", *grParam.MinLongitude}}})
+	}
+	if grParam.MaxLongitude != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.longitude", bson.D{{"$lte", *grParam.MaxLongitude}}})
+	}
+	if grParam.MinDepth != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$gte", *grParam.MinDepth}}})
+	}
+	if grParam.MaxDepth != nil {
+		filters = append(filters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$lte", *grParam.MaxDepth}}})
+		filters = append(filters, bson.E{"earthquake.hypocenter.depth", bson.D{{"$gte", 0}}})
+	}
+	return filters
+}
+
+// findMagnitudes は地震ごとに最新の情報のマグニチュードを返す.
+func findMagnitudes(ctx context.Context, filters bson.D) ([]float64, error) {
+	pipeline := append(quakeEventStages(filters, "earthquake.hypocenter.magnitude"),
+		bson.D{{"$project", bson.D{{"_id", 0}, {"magnitude", "$earthquake.hypocenter.magnitude"}}}},
+	)
+	cur, err := jmaCollection.Aggregate(ctx, pipeline, aggregateOptions())
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var results []bson.M
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	magnitudes := make([]float64, 0, len(results))
+	for _, result := range results {
+		if magnitude, ok := recordFloat(result, "magnitude"); ok {
+			magnitudes = append(magnitudes, magnitude)
+		}
+	}
+	return magnitudes, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import "time"
+
+const (
+	// 地震感知情報などのピア由来の情報は約 1 週間分のみ保持しているため、それ以上の期間は指定できない.
+	maxTimeRange     = 7 * 24 * time.Hour
+	defaultTimeRange = 24 * time.Hour
+)
+
+// parseTimeRange は since_time と until_time を解釈する. 省略した場合は直近 24 時間とする.
+func parseTimeRange(sinceTime string, untilTime string) (time.Time, time.Time, []InvalidParam) {
+	var invalidParams []InvalidParam
+
+	until := time.Now().In(jst)
+	if untilTime != "" {
+		t, err := time.Parse(time.RFC3339, untilTime)
+		if err != nil {
+			invalidParams = append(invalidParams, dateTimeError("until_time", untilTime))
+		}
+		until = t.In(jst)
+	}
+	since := until.Add(-defaultTimeRange)
+	if sinceTime != "" {
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			invalidParams = append(invalidParams, dateTimeError("since_time", sinceTime))
+		}
+		since = t.In(jst)
+	}
+	if len(invalidParams) > 0 {
+		return since, until, invalidParams
+	}
+
+	if since.After(until) {
+		invalidParams = append(invalidParams, orderedFieldError("since_time", "until_time", sinceTime))
+	} else if until.Sub(since) > maxTimeRange {
+		invalidParams = append(invalidParams, InvalidParam{
+			Name:   "since_time",
+			Reason: "range between since_time and until_time must be 7 days or less",
+			Rule:   "maxrange=168h",
+			Value:  sinceTime,
+		})
+	}
+	return since, until, invalidParams
+}
+
+func dateTimeError(name string, value string) InvalidParam {
+	return InvalidParam{Name: name, Reason: "must be RFC 3339 date-time", Rule: "format=date-time", Value: value}
+}
+
+// parseOptionalTimeRange は since_time と until_time を解釈する. 省略した側はゼロ値とし、期間を限らない.
+func parseOptionalTimeRange(sinceTime string, untilTime string) (time.Time, time.Time, []InvalidParam) {
+	var invalidParams []InvalidParam
+	var since, until time.Time
+	if sinceTime != "" {
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			invalidParams = append(invalidParams, dateTimeError("since_time", sinceTime))
+		}
+		since = t.In(jst)
+	}
+	if untilTime != "" {
+		t, err := time.Parse(time.RFC3339, untilTime)
+		if err != nil {
+			invalidParams = append(invalidParams, dateTimeError("until_time", untilTime))
+		}
+		until = t.In(jst)
+	}
+	if len(invalidParams) == 0 && !since.IsZero() && !until.IsZero() && since.After(until) {
+		invalidParams = append(invalidParams, orderedFieldError("since_time", "until_time", sinceTime))
+	}
+	return since, until, invalidParams
+}
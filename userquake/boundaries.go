@@ -0,0 +1,66 @@
+package userquake
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Boundaries は地域コードごとの境界 (GeoJSON の Polygon または MultiPolygon) を表す.
+// 正確な境界は同梱していないため、気象庁の予報区等 GIS データなどから作成した GeoJSON を LoadBoundaries で読み込む.
+type Boundaries map[int]json.RawMessage
+
+// approximateBoundaries は代表点のボロノイ分割から作成した近似的な境界 (cmd/genboundaries で作成する).
+//
+//go:generate go run ../cmd/genboundaries -o approximate_boundaries.geojson
+//go:embed approximate_boundaries.geojson
+var approximateBoundaries []byte
+
+// ApproximateBoundaries は同梱の近似的な境界を返す. 代表点のボロノイ分割を代表点から 80 km 以内に制限したもので、
+// 実際の地域の境界ではなく海上にも広がる. 代表点のある地域すべてを含む.
+func ApproximateBoundaries() Boundaries {
+	boundaries, err := LoadBoundaries(bytes.NewReader(approximateBoundaries))
+	if err != nil {
+		panic(err)
+	}
+	return boundaries
+}
+
+// LoadBoundaries は各 Feature の properties.code に地域コードを持つ GeoJSON FeatureCollection を読み込む.
+func LoadBoundaries(r io.Reader) (Boundaries, error) {
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry   json.RawMessage `json:"geometry"`
+			Properties struct {
+				Code *int `json:"code"`
+			} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.NewDecoder(r).Decode(&collection); err != nil {
+		return nil, err
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("unexpected GeoJSON type: %q", collection.Type)
+	}
+
+	boundaries := Boundaries{}
+	for i, feature := range collection.Features {
+		if feature.Properties.Code == nil {
+			return nil, fmt.Errorf("feature %d has no code property", i)
+		}
+		var geometry struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(feature.Geometry, &geometry); err != nil {
+			return nil, fmt.Errorf("feature %d: %v", i, err)
+		}
+		if geometry.Type != "Polygon" && geometry.Type != "MultiPolygon" {
+			return nil, fmt.Errorf("feature %d: unexpected geometry type: %q", i, geometry.Type)
+		}
+		boundaries[*feature.Properties.Code] = feature.Geometry
+	}
+	return boundaries, nil
+}
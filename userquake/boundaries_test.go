@@ -0,0 +1,65 @@
+package userquake
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestApproximateBoundaries(t *testing.T) {
+	boundaries := ApproximateBoundaries()
+
+	Areas.Each(func(area Area) bool {
+		_, hasCentroid := Centroid(area.Code)
+		boundary, hasBoundary := boundaries[area.Code]
+		if hasCentroid != hasBoundary {
+			t.Errorf("area %d: centroid %v, boundary %v", area.Code, hasCentroid, hasBoundary)
+			return true
+		}
+		if !hasBoundary {
+			return true
+		}
+
+		var polygon struct {
+			Type        string         `json:"type"`
+			Coordinates [][][2]float64 `json:"coordinates"`
+		}
+		if err := json.Unmarshal(boundary, &polygon); err != nil {
+			t.Errorf("area %d: %v", area.Code, err)
+			return true
+		}
+		ring := polygon.Coordinates[0]
+		if polygon.Type != "Polygon" || len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+			t.Errorf("area %d: not a closed polygon", area.Code)
+		}
+		return true
+	})
+}
+
+func TestLoadBoundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		geojson string
+		wantErr string
+	}{
+		{"valid", `{"type":"FeatureCollection","features":[{"geometry":{"type":"MultiPolygon","coordinates":[]},"properties":{"code":250}}]}`, ""},
+		{"not a collection", `{"type":"Feature"}`, "unexpected GeoJSON type"},
+		{"missing code", `{"type":"FeatureCollection","features":[{"geometry":{"type":"Polygon","coordinates":[]},"properties":{}}]}`, "no code property"},
+		{"point geometry", `{"type":"FeatureCollection","features":[{"geometry":{"type":"Point","coordinates":[0,0]},"properties":{"code":250}}]}`, "unexpected geometry type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boundaries, err := LoadBoundaries(strings.NewReader(tt.geojson))
+			if tt.wantErr == "" {
+				if err != nil || len(boundaries) != 1 {
+					t.Errorf("LoadBoundaries() = %v, %v", boundaries, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadBoundaries() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package userquake
+
+import (
+	"sort"
+	"strings"
+)
+
+// Area は地震感知情報の地域を表す.
+type Area struct {
+	Code       int    `json:"code"`
+	Region     string `json:"region"`
+	Prefecture string `json:"prefecture"`
+	Name       string `json:"name"`
+}
+
+// Catalog は地域の一覧と、地域コード・地方・都道府県・地域名による索引を保持する.
+// 作成後は変更しないため、複数の goroutine から参照できる.
+type Catalog struct {
+	areas        []Area
+	byCode       map[int]Area
+	byName       map[string]Area
+	byRegion     map[string][]Area
+	byPrefecture map[string][]Area
+	regions      []string
+	prefectures  []string
+}
+
+// Areas は P2P地震情報で用いる地域の一覧.
+var Areas = NewCatalog(areas)
+
+// NewCatalog は地域コード順に並べ替えた Catalog を返す.
+func NewCatalog(list []Area) *Catalog {
+	c := &Catalog{
+		areas:        make([]Area, len(list)),
+		byCode:       map[int]Area{},
+		byName:       map[string]Area{},
+		byRegion:     map[string][]Area{},
+		byPrefecture: map[string][]Area{},
+	}
+	copy(c.areas, list)
+	sort.SliceStable(c.areas, func(i, j int) bool { return c.areas[i].Code < c.areas[j].Code })
+
+	for _, area := range c.areas {
+		c.byCode[area.Code] = area
+		c.byName[area.Name] = area
+		if _, ok := c.byRegion[area.Region]; !ok {
+			c.regions = append(c.regions, area.Region)
+		}
+		c.byRegion[area.Region] = append(c.byRegion[area.Region], area)
+		if _, ok := c.byPrefecture[area.Prefecture]; !ok {
+			c.prefectures = append(c.prefectures, area.Prefecture)
+		}
+		c.byPrefecture[area.Prefecture] = append(c.byPrefecture[area.Prefecture], area)
+	}
+	return c
+}
+
+// ByCode は地域コードに対応する地域を返す.
+func (c *Catalog) ByCode(code int) (Area, bool) {
+	area, ok := c.byCode[code]
+	return area, ok
+}
+
+// ByName は地域名 ("伊豆諸島北部" など) に対応する地域を返す.
+func (c *Catalog) ByName(name string) (Area, bool) {
+	area, ok := c.byName[name]
+	return area, ok
+}
+
+// ByScaleArea は地震情報の震度速報の区域 ("宮城県南部" など) を含む地域を返す. prefecture は区域の都道府県 ("宮城県" など).
+// 区域は地域より細かいため、同じ都道府県の地域のうち、都道府県名を除いた地域名が区域名の先頭に一致する最も長いものとする.
+// 地域名が都道府県名のみの地域 ("東京" など) は、ほかに一致する地域がない場合に用いる.
+func (c *Catalog) ByScaleArea(prefecture string, name string) (Area, bool) {
+	if area, ok := c.byName[name]; ok {
+		return area, true
+	}
+
+	normalized := NormalizePrefecture(prefecture)
+	rest := strings.Replace(strings.TrimPrefix(name, prefecture), "地方", "", 1)
+	var found Area
+	longest := -1
+	for _, area := range c.byPrefecture[normalized] {
+		suffix := strings.TrimSpace(strings.TrimPrefix(area.Name, normalized))
+		if strings.HasPrefix(rest, suffix) && len(suffix) > longest {
+			found, longest = area, len(suffix)
+		}
+	}
+	return found, longest >= 0
+}
+
+// ByRegion は地方 ("関東" など) に属する地域を地域コード順に返す.
+func (c *Catalog) ByRegion(region string) []Area {
+	return append([]Area(nil), c.byRegion[region]...)
+}
+
+// ByPrefecture は都道府県 ("東京" など) に属する地域を地域コード順に返す.
+func (c *Catalog) ByPrefecture(prefecture string) []Area {
+	return append([]Area(nil), c.byPrefecture[prefecture]...)
+}
+
+// List はすべての地域を地域コード順に返す.
+func (c *Catalog) List() []Area {
+	return append([]Area(nil), c.areas...)
+}
+
+// Each はすべての地域について地域コード順に f を呼び出す. f が false を返すと中断する.
+func (c *Catalog) Each(f func(Area) bool) {
+	for _, area := range c.areas {
+		if !f(area) {
+			return
+		}
+	}
+}
+
+// Len は地域の件数を返す.
+func (c *Catalog) Len() int {
+	return len(c.areas)
+}
+
+// Regions は地方の一覧を、最初に現れる地域コードの順に返す.
+func (c *Catalog) Regions() []string {
+	return append([]string(nil), c.regions...)
+}
+
+// Prefectures は都道府県の一覧を、最初に現れる地域コードの順に返す.
+func (c *Catalog) Prefectures() []string {
+	return append([]string(nil), c.prefectures...)
+}
@@ -0,0 +1,33 @@
+package userquake
+
+import "testing"
+
+func TestCatalogByScaleArea(t *testing.T) {
+	tests := []struct {
+		prefecture string
+		name       string
+		want       string
+		wantOK     bool
+	}{
+		{"宮城県", "宮城県南部", "宮城南部", true},
+		{"岩手県", "岩手県沿岸南部", "岩手沿岸南部", true},
+		{"石川県", "石川県能登", "石川能登", true},
+		{"北海道", "石狩地方北部", "北海道 石狩", true},
+		{"沖縄県", "宮古島地方", "沖縄宮古島", true},
+		{"沖縄県", "沖縄本島北部", "沖縄本島北部", true},
+		{"東京都", "伊豆諸島北部", "伊豆諸島北部", true},
+		{"東京都", "東京都２３区", "東京", true},
+		{"鹿児島県", "種子島・屋久島地方", "種子島・屋久島", true},
+		{"北海道", "北海道奥尻島", "", false},
+		{"兵庫県", "兵庫県南東部", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area, ok := Areas.ByScaleArea(tt.prefecture, tt.name)
+			if ok != tt.wantOK || (ok && area.Name != tt.want) {
+				t.Errorf("ByScaleArea(%q, %q) = %q, %v, want %q, %v", tt.prefecture, tt.name, area.Name, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
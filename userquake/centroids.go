@@ -0,0 +1,156 @@
+package userquake
+
+// Coordinate は緯度・経度 (度) を表す.
+type Coordinate struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// centroids は地域の代表点. 各地域のおおよその中心で、地域の内側にあるとは限らない.
+// 未設定 (0) ・不明 (901) ・外国 (905) には代表点がない.
+var centroids = map[int]Coordinate{
+	10:  {43.10, 141.40},
+	15:  {41.85, 140.65},
+	20:  {41.90, 140.15},
+	25:  {42.90, 140.65},
+	30:  {43.35, 141.95},
+	35:  {43.75, 142.45},
+	40:  {44.30, 141.80},
+	45:  {45.15, 142.00},
+	50:  {43.85, 144.15},
+	55:  {42.55, 141.35},
+	60:  {42.40, 142.65},
+	65:  {42.95, 143.20},
+	70:  {43.15, 144.25},
+	75:  {43.45, 145.10},
+	100: {40.70, 140.40},
+	105: {40.55, 141.25},
+	106: {41.25, 141.05},
+	110: {39.90, 141.75},
+	111: {39.20, 141.70},
+	115: {39.50, 141.10},
+	120: {38.65, 141.05},
+	125: {38.15, 140.75},
+	130: {39.75, 140.10},
+	135: {39.70, 140.55},
+	140: {38.80, 139.90},
+	141: {38.75, 140.30},
+	142: {38.35, 140.30},
+	143: {37.95, 140.05},
+	150: {37.40, 140.40},
+	151: {37.35, 140.90},
+	152: {37.45, 139.75},
+	200: {36.55, 140.40},
+	205: {36.10, 140.20},
+	210: {36.85, 139.80},
+	215: {36.40, 139.80},
+	220: {36.70, 139.00},
+	225: {36.30, 139.00},
+	230: {36.10, 139.35},
+	231: {35.90, 139.65},
+	232: {35.95, 139.00},
+	240: {35.70, 140.45},
+	241: {35.70, 140.05},
+	242: {35.15, 140.10},
+	250: {35.70, 139.50},
+	255: {34.45, 139.35},
+	260: {33.10, 139.80},
+	265: {27.10, 142.20},
+	270: {35.45, 139.55},
+	275: {35.40, 139.15},
+	300: {37.05, 138.25},
+	301: {37.35, 138.85},
+	302: {37.85, 139.30},
+	305: {38.05, 138.40},
+	310: {36.65, 137.40},
+	315: {36.65, 136.95},
+	320: {37.15, 136.90},
+	325: {36.40, 136.55},
+	330: {35.95, 136.30},
+	335: {35.55, 135.90},
+	340: {35.60, 138.85},
+	345: {35.55, 138.45},
+	350: {36.60, 138.20},
+	351: {36.15, 137.95},
+	355: {35.60, 137.90},
+	400: {36.10, 137.25},
+	405: {35.55, 136.85},
+	410: {34.90, 138.95},
+	411: {35.15, 138.70},
+	415: {35.05, 138.30},
+	416: {34.85, 137.80},
+	420: {34.85, 137.40},
+	425: {35.10, 136.95},
+	430: {34.75, 136.40},
+	435: {34.05, 136.15},
+	440: {35.45, 136.15},
+	445: {35.05, 136.05},
+	450: {35.45, 135.25},
+	455: {35.00, 135.70},
+	460: {34.75, 135.50},
+	465: {34.50, 135.50},
+	470: {35.40, 134.75},
+	475: {34.85, 134.85},
+	480: {34.35, 135.85},
+	490: {34.15, 135.30},
+	495: {33.75, 135.60},
+	500: {35.40, 134.25},
+	505: {35.35, 133.60},
+	510: {35.30, 132.85},
+	515: {34.85, 132.10},
+	514: {36.20, 133.25},
+	520: {35.05, 133.75},
+	525: {34.70, 133.85},
+	530: {34.75, 132.80},
+	535: {34.40, 132.65},
+	540: {34.35, 131.55},
+	545: {34.05, 131.90},
+	541: {34.10, 131.10},
+	550: {34.05, 134.25},
+	555: {33.80, 134.40},
+	560: {34.25, 134.00},
+	570: {33.90, 133.20},
+	575: {33.75, 132.80},
+	576: {33.30, 132.55},
+	580: {33.55, 134.05},
+	581: {33.60, 133.45},
+	582: {33.05, 132.90},
+	600: {33.60, 130.35},
+	601: {33.85, 130.85},
+	602: {33.65, 130.75},
+	605: {33.25, 130.55},
+	610: {33.35, 130.15},
+	615: {33.20, 130.15},
+	620: {33.25, 129.75},
+	625: {32.80, 129.95},
+	630: {34.35, 129.45},
+	635: {32.75, 128.80},
+	640: {32.95, 131.05},
+	641: {32.75, 130.75},
+	645: {32.25, 130.80},
+	646: {32.40, 130.30},
+	650: {33.50, 131.45},
+	651: {33.25, 131.60},
+	655: {33.20, 131.15},
+	656: {32.95, 131.70},
+	660: {32.40, 131.55},
+	661: {32.55, 131.15},
+	665: {31.80, 131.40},
+	666: {31.95, 131.00},
+	670: {31.65, 130.40},
+	675: {31.40, 130.90},
+	680: {30.45, 130.75},
+	685: {28.30, 129.45},
+	700: {26.65, 128.05},
+	701: {26.30, 127.75},
+	702: {26.35, 126.80},
+	710: {25.85, 131.25},
+	706: {24.80, 125.30},
+	705: {24.35, 124.05},
+}
+
+// Centroid は地域コードに対応する代表点を返す.
+func Centroid(code int) (Coordinate, bool) {
+	coordinate, ok := centroids[code]
+	return coordinate, ok
+}
@@ -0,0 +1,153 @@
+package userquake
+
+import "time"
+
+// ClusterOptions は地震感知情報をまとめる条件を表す.
+type ClusterOptions struct {
+	// Gap は直前の感知情報からこの時間以上空いた場合に別のまとまりとする.
+	Gap time.Duration
+	// MinCount は出力するまとまりの件数の下限. AreaWeights を指定した場合は重み付けした件数で比較する.
+	// MaxDuration で区切ったまとまりは、 Gap で区切られるまでの一続きの合計で比較する.
+	MinCount float64
+	// MaxDuration はまとまりの最初の感知情報からの時間の上限. 0 の場合は制限しない.
+	MaxDuration time.Duration
+	// AreaWeights は地域コードごとの重み. 指定のない地域の重みは 1.
+	AreaWeights map[int]float64
+}
+
+// DefaultClusterOptions は 30 秒以上空いたら区切り、 3 件以上のまとまりを出力する.
+var DefaultClusterOptions = ClusterOptions{
+	Gap:      30 * time.Second,
+	MinCount: 3,
+}
+
+// Report は 1 件の地震感知情報を表す. Data は呼び出し元が元の情報を保持するために利用する.
+type Report struct {
+	Time time.Time
+	Area int
+	Data interface{}
+}
+
+// Cluster は時間的に近い地震感知情報のまとまりを表す.
+type Cluster struct {
+	Start   time.Time
+	End     time.Time
+	Weight  float64
+	Reports []Report
+}
+
+// Clusterer は時刻の昇順に与えられた地震感知情報を順にまとめる.
+type Clusterer struct {
+	options ClusterOptions
+	current *Cluster
+	// held は MaxDuration で区切ったが、一続きの合計がまだ件数の下限に満たないまとまり.
+	held []Cluster
+	// runWeight は Gap で区切られるまでの一続きの重み付けした件数.
+	runWeight float64
+}
+
+func NewClusterer(options ClusterOptions) *Clusterer {
+	return &Clusterer{options: options}
+}
+
+// Add は感知情報を追加する. それまでのまとまりが確定し、件数の下限を満たしていれば時刻の昇順に返す.
+func (c *Clusterer) Add(report Report) []Cluster {
+	var finished []Cluster
+	if c.current != nil {
+		if report.Time.Sub(c.current.End) >= c.options.Gap {
+			finished = c.Flush()
+		} else if c.options.MaxDuration > 0 && report.Time.Sub(c.current.Start) > c.options.MaxDuration {
+			c.held = append(c.held, *c.current)
+			c.current = nil
+			finished = c.release()
+		}
+	}
+
+	if c.current == nil {
+		c.current = &Cluster{Start: report.Time}
+	}
+	w := c.weight(report.Area)
+	c.current.End = report.Time
+	c.current.Weight += w
+	c.current.Reports = append(c.current.Reports, report)
+	c.runWeight += w
+	return finished
+}
+
+// Flush は現在のまとまりを確定し、件数の下限を満たしていれば時刻の昇順に返す.
+func (c *Clusterer) Flush() []Cluster {
+	if c.current != nil {
+		c.held = append(c.held, *c.current)
+		c.current = nil
+	}
+	finished := c.release()
+	c.held = nil
+	c.runWeight = 0
+	return finished
+}
+
+// release は一続きの合計が件数の下限を満たしていれば、保留しているまとまりを返す.
+func (c *Clusterer) release() []Cluster {
+	if len(c.held) == 0 || c.runWeight < c.options.MinCount {
+		return nil
+	}
+	released := c.held
+	c.held = nil
+	return released
+}
+
+func (c *Clusterer) weight(area int) float64 {
+	if w, ok := c.options.AreaWeights[area]; ok {
+		return w
+	}
+	return 1
+}
+
+// Cluster は時刻の昇順に並んだ感知情報をまとめる.
+func (o ClusterOptions) Cluster(reports []Report) []Cluster {
+	var clusters []Cluster
+	clusterer := NewClusterer(o)
+	for _, report := range reports {
+		clusters = append(clusters, clusterer.Add(report)...)
+	}
+	return append(clusters, clusterer.Flush()...)
+}
+
+// Count は感知情報の件数を返す.
+func (c Cluster) Count() int {
+	return len(c.Reports)
+}
+
+// AreaCodes は地域コードごとの件数を返す.
+func (c Cluster) AreaCodes() map[int]int {
+	codes := map[int]int{}
+	for _, report := range c.Reports {
+		codes[report.Area] += 1
+	}
+	return codes
+}
+
+// Regions は地方ごとの件数を返す. 地域一覧にない地域コードは数えない.
+func (c Cluster) Regions() map[string]int {
+	return c.countBy(func(area Area) string { return area.Region })
+}
+
+// Prefectures は都道府県ごとの件数を返す.
+func (c Cluster) Prefectures() map[string]int {
+	return c.countBy(func(area Area) string { return area.Prefecture })
+}
+
+// Areas は地域名ごとの件数を返す.
+func (c Cluster) Areas() map[string]int {
+	return c.countBy(func(area Area) string { return area.Name })
+}
+
+func (c Cluster) countBy(key func(Area) string) map[string]int {
+	counts := map[string]int{}
+	for _, report := range c.Reports {
+		if area, ok := Areas.ByCode(report.Area); ok {
+			counts[key(area)] += 1
+		}
+	}
+	return counts
+}
@@ -0,0 +1,178 @@
+package userquake
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var clusterBase = time.Date(2021, 5, 28, 21, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+// reportsAt は基準時刻からの秒数ごとに、地域コード 250 の感知情報を作る.
+func reportsAt(seconds ...int) []Report {
+	reports := make([]Report, 0, len(seconds))
+	for _, s := range seconds {
+		reports = append(reports, Report{Time: clusterBase.Add(time.Duration(s) * time.Second), Area: 250})
+	}
+	return reports
+}
+
+// clusterSizes はまとまりごとの件数を返す.
+func clusterSizes(clusters []Cluster) []int {
+	sizes := []int{}
+	for _, cluster := range clusters {
+		sizes = append(sizes, cluster.Count())
+	}
+	return sizes
+}
+
+func TestClusterOptionsCluster(t *testing.T) {
+	weighted := func(areas ...int) []Report {
+		reports := []Report{}
+		for i, area := range areas {
+			reports = append(reports, Report{Time: clusterBase.Add(time.Duration(i) * time.Second), Area: area})
+		}
+		return reports
+	}
+
+	tests := []struct {
+		name    string
+		options ClusterOptions
+		reports []Report
+		want    []int
+	}{
+		{
+			name:    "no reports",
+			options: DefaultClusterOptions,
+			reports: nil,
+			want:    []int{},
+		},
+		{
+			name:    "gap just under the limit keeps one cluster",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3},
+			reports: reportsAt(0, 10, 20, 49),
+			want:    []int{4},
+		},
+		{
+			name:    "gap of exactly Gap splits",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3},
+			reports: reportsAt(0, 10, 20, 50, 51, 52),
+			want:    []int{3, 3},
+		},
+		{
+			name:    "clusters under MinCount are dropped",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3},
+			reports: reportsAt(0, 10, 100, 101, 102),
+			want:    []int{3},
+		},
+		{
+			name:    "heavy area weights reach MinCount",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, AreaWeights: map[int]float64{250: 2}},
+			reports: weighted(250, 250),
+			want:    []int{2},
+		},
+		{
+			name:    "light area weights miss MinCount",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, AreaWeights: map[int]float64{250: 0.5}},
+			reports: weighted(250, 250, 275, 250),
+			want:    []int{},
+		},
+		{
+			name:    "unweighted areas count as one",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, AreaWeights: map[int]float64{250: 0.5}},
+			reports: weighted(250, 250, 275, 275),
+			want:    []int{4},
+		},
+		{
+			name:    "MaxDuration splits a run that reaches MinCount in total",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, MaxDuration: 15 * time.Second},
+			reports: reportsAt(0, 10, 20, 30, 40),
+			want:    []int{2, 2, 1},
+		},
+		{
+			name:    "MaxDuration pieces are dropped when the run misses MinCount",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, MaxDuration: 5 * time.Second},
+			reports: reportsAt(0, 10),
+			want:    []int{},
+		},
+		{
+			name:    "MinCount is evaluated per run separated by Gap",
+			options: ClusterOptions{Gap: 30 * time.Second, MinCount: 3, MaxDuration: 15 * time.Second},
+			reports: reportsAt(0, 10, 20, 100, 110),
+			want:    []int{2, 1},
+		},
+		{
+			name:    "DefaultClusterOptions keeps 30 seconds and 3 reports",
+			options: DefaultClusterOptions,
+			reports: reportsAt(0, 29, 58, 88, 100, 200, 201, 202),
+			want:    []int{3, 3},
+		},
+		{
+			name:    "DefaultClusterOptions drops two reports",
+			options: DefaultClusterOptions,
+			reports: reportsAt(0, 29, 59, 60),
+			want:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clusterSizes(tt.options.Cluster(tt.reports))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cluster sizes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultClusterOptions(t *testing.T) {
+	want := ClusterOptions{Gap: 30 * time.Second, MinCount: 3}
+	if !reflect.DeepEqual(DefaultClusterOptions, want) {
+		t.Errorf("DefaultClusterOptions = %+v, want %+v", DefaultClusterOptions, want)
+	}
+}
+
+func TestClustererFlushEmpty(t *testing.T) {
+	clusterer := NewClusterer(DefaultClusterOptions)
+	if clusters := clusterer.Flush(); len(clusters) != 0 {
+		t.Errorf("Flush() on empty clusterer = %v, want none", clusters)
+	}
+
+	clusterer.Add(reportsAt(0)[0])
+	clusterer.Flush()
+	if clusters := clusterer.Flush(); len(clusters) != 0 {
+		t.Errorf("second Flush() = %v, want none", clusters)
+	}
+}
+
+func TestClustererAdd(t *testing.T) {
+	clusterer := NewClusterer(ClusterOptions{Gap: 30 * time.Second, MinCount: 3, MaxDuration: 15 * time.Second})
+
+	var returned [][]int
+	for _, report := range reportsAt(0, 10, 20, 30, 40) {
+		returned = append(returned, clusterSizes(clusterer.Add(report)))
+	}
+	returned = append(returned, clusterSizes(clusterer.Flush()))
+
+	// 20 秒の時点では一続きの件数が 2 件で保留し、 40 秒の時点で下限を満たしたものから返す.
+	want := [][]int{{}, {}, {}, {}, {2, 2}, {1}}
+	if !reflect.DeepEqual(returned, want) {
+		t.Errorf("returned cluster sizes = %v, want %v", returned, want)
+	}
+}
+
+func TestClusterWeightAndSpan(t *testing.T) {
+	options := ClusterOptions{Gap: 30 * time.Second, MinCount: 1, AreaWeights: map[int]float64{250: 0.5}}
+	clusters := options.Cluster(reportsAt(0, 5, 12))
+	if len(clusters) != 1 {
+		t.Fatalf("got %d clusters, want 1", len(clusters))
+	}
+
+	cluster := clusters[0]
+	if cluster.Weight != 1.5 {
+		t.Errorf("Weight = %v, want 1.5", cluster.Weight)
+	}
+	if !cluster.Start.Equal(clusterBase) || !cluster.End.Equal(clusterBase.Add(12*time.Second)) {
+		t.Errorf("span = %v - %v, want %v - %v", cluster.Start, cluster.End, clusterBase, clusterBase.Add(12*time.Second))
+	}
+}
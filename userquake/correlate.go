@@ -0,0 +1,141 @@
+package userquake
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Quake は地震感知情報のまとまりと照合する地震情報を表す.
+type Quake struct {
+	ID string
+	// Time は地震の発生日時. 気象庁の地震情報では分単位.
+	Time time.Time
+	// IssueTime は最初に地震情報が発表された日時.
+	IssueTime time.Time
+	// Prefectures は震度観測点の都道府県 ("東京都" など).
+	Prefectures []string
+	// Data は呼び出し元が元の情報を保持するために利用する.
+	Data interface{}
+}
+
+// CorrelationOptions は地震感知情報のまとまりと地震情報を照合する時間幅を表す.
+// 地震の発生日時がまとまりの開始日時の Before 前から After 後までの範囲にあれば対象とする.
+type CorrelationOptions struct {
+	Before time.Duration
+	After  time.Duration
+}
+
+// DefaultCorrelationOptions は発生日時が分単位であることと、感知から報告までの遅れを考慮した時間幅.
+var DefaultCorrelationOptions = CorrelationOptions{
+	Before: 3 * time.Minute,
+	After:  1 * time.Minute,
+}
+
+// Match は地震感知情報のまとまりに対応する地震情報を表す.
+type Match struct {
+	Quake Quake
+	// LeadTime は地震感知情報のまとまりの開始から、地震情報が最初に発表されるまでの時間.
+	LeadTime time.Duration
+	// Prefectures は地震感知情報と震度観測点の両方に含まれる都道府県 (地域一覧の表記).
+	Prefectures []string
+}
+
+// Correlate は時間幅に発生し、震度観測点の都道府県が感知した地域の都道府県と重なる地震情報を、発生日時が近い順に返す.
+func (o CorrelationOptions) Correlate(cluster Cluster, quakes []Quake) []Match {
+	prefectures := map[string]bool{}
+	for prefecture := range cluster.Prefectures() {
+		prefectures[NormalizePrefecture(prefecture)] = true
+	}
+
+	var matches []Match
+	for _, quake := range quakes {
+		if quake.Time.Before(cluster.Start.Add(-o.Before)) || quake.Time.After(cluster.Start.Add(o.After)) {
+			continue
+		}
+
+		overlap := map[string]bool{}
+		for _, prefecture := range quake.Prefectures {
+			if p := NormalizePrefecture(prefecture); prefectures[p] {
+				overlap[p] = true
+			}
+		}
+		if len(overlap) == 0 {
+			continue
+		}
+
+		match := Match{Quake: quake, LeadTime: quake.IssueTime.Sub(cluster.Start)}
+		for prefecture := range overlap {
+			match.Prefectures = append(match.Prefectures, prefecture)
+		}
+		sort.Strings(match.Prefectures)
+		matches = append(matches, match)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return AbsDuration(matches[i].Quake.Time.Sub(cluster.Start)) < AbsDuration(matches[j].Quake.Time.Sub(cluster.Start))
+	})
+	return matches
+}
+
+// NormalizePrefecture は "東京都" "大阪府" "神奈川県" などの都道府県名を、地域一覧の表記 ("東京" など) に揃える.
+func NormalizePrefecture(name string) string {
+	if name == "北海道" {
+		return name
+	}
+	for _, suffix := range []string{"都", "府", "県"} {
+		if trimmed := strings.TrimSuffix(name, suffix); trimmed != name && trimmed != "" {
+			return trimmed
+		}
+	}
+	return name
+}
+
+// LeadTimeStats は地震感知情報から地震情報の発表までの時間の統計を表す.
+type LeadTimeStats struct {
+	Count  int
+	Min    time.Duration
+	Max    time.Duration
+	Mean   time.Duration
+	Median time.Duration
+	P90    time.Duration
+}
+
+// LeadTimeStatistics は時間の一覧から統計を求める. 分位数は線形補間で求める.
+func LeadTimeStatistics(leadTimes []time.Duration) LeadTimeStats {
+	stats := LeadTimeStats{Count: len(leadTimes)}
+	if len(leadTimes) == 0 {
+		return stats
+	}
+
+	sorted := append([]time.Duration(nil), leadTimes...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	stats.Mean = sum / time.Duration(len(sorted))
+	stats.Median = quantile(sorted, 0.5)
+	stats.P90 = quantile(sorted, 0.9)
+	return stats
+}
+
+func quantile(sorted []time.Duration, q float64) time.Duration {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	fraction := position - float64(lower)
+	return sorted[lower] + time.Duration(fraction*float64(sorted[upper]-sorted[lower]))
+}
+
+// AbsDuration は時間の差の絶対値を返す.
+func AbsDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
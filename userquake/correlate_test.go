@@ -0,0 +1,131 @@
+package userquake
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCorrelationOptionsCorrelate(t *testing.T) {
+	cluster := Cluster{
+		Start: clusterBase,
+		Reports: []Report{
+			{Time: clusterBase, Area: 250},                      // 東京
+			{Time: clusterBase.Add(time.Second), Area: 270},     // 神奈川
+			{Time: clusterBase.Add(2 * time.Second), Area: 10},  // 北海道
+			{Time: clusterBase.Add(3 * time.Second), Area: 250}, // 東京
+		},
+	}
+	quake := func(id string, offset time.Duration, prefectures ...string) Quake {
+		return Quake{ID: id, Time: clusterBase.Add(offset), IssueTime: clusterBase.Add(offset + 2*time.Minute), Prefectures: prefectures}
+	}
+
+	tests := []struct {
+		name  string
+		quake Quake
+		want  []string
+	}{
+		{"3 minutes before", quake("before", -3*time.Minute, "東京都"), []string{"東京"}},
+		{"more than 3 minutes before", quake("before", -3*time.Minute-time.Second, "東京都"), nil},
+		{"1 minute after", quake("after", time.Minute, "東京都"), []string{"東京"}},
+		{"more than 1 minute after", quake("after", time.Minute+time.Second, "東京都"), nil},
+		{"prefecture with 県", quake("kanagawa", 0, "神奈川県"), []string{"神奈川"}},
+		{"北海道", quake("hokkaido", 0, "北海道"), []string{"北海道"}},
+		{"prefecture without reports", quake("osaka", 0, "大阪府"), nil},
+		{"overlapping prefectures", quake("overlap", 0, "東京都", "大阪府", "東京都", "神奈川県"), []string{"東京", "神奈川"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := DefaultCorrelationOptions.Correlate(cluster, []Quake{tt.quake})
+			if tt.want == nil {
+				if len(matches) != 0 {
+					t.Errorf("Correlate() = %v, want no matches", matches)
+				}
+				return
+			}
+			if len(matches) != 1 {
+				t.Fatalf("Correlate() returned %d matches, want 1", len(matches))
+			}
+			want := append([]string(nil), tt.want...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(matches[0].Prefectures, want) {
+				t.Errorf("Prefectures = %v, want %v", matches[0].Prefectures, want)
+			}
+			if leadTime := tt.quake.IssueTime.Sub(cluster.Start); matches[0].LeadTime != leadTime {
+				t.Errorf("LeadTime = %v, want %v", matches[0].LeadTime, leadTime)
+			}
+		})
+	}
+
+	t.Run("nearest first", func(t *testing.T) {
+		quakes := []Quake{
+			quake("far", -2*time.Minute, "東京都"),
+			quake("after", 30*time.Second, "東京都"),
+			quake("near", -10*time.Second, "東京都"),
+		}
+		var ids []string
+		for _, match := range DefaultCorrelationOptions.Correlate(cluster, quakes) {
+			ids = append(ids, match.Quake.ID)
+		}
+		if want := []string{"near", "after", "far"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("Correlate() order = %v, want %v", ids, want)
+		}
+	})
+}
+
+func TestNormalizePrefecture(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"東京都", "東京"},
+		{"京都府", "京都"},
+		{"大阪府", "大阪"},
+		{"神奈川県", "神奈川"},
+		{"北海道", "北海道"},
+		{"東京", "東京"},
+		{"県", "県"},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizePrefecture(tt.name); got != tt.want {
+			t.Errorf("NormalizePrefecture(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLeadTimeStatistics(t *testing.T) {
+	seconds := func(values ...float64) []time.Duration {
+		durations := make([]time.Duration, 0, len(values))
+		for _, v := range values {
+			durations = append(durations, time.Duration(v*float64(time.Second)))
+		}
+		return durations
+	}
+
+	tests := []struct {
+		name      string
+		leadTimes []time.Duration
+		want      LeadTimeStats
+	}{
+		{"no samples", nil, LeadTimeStats{}},
+		{"one sample", seconds(90), LeadTimeStats{Count: 1, Min: 90 * time.Second, Max: 90 * time.Second, Mean: 90 * time.Second, Median: 90 * time.Second, P90: 90 * time.Second}},
+		// 中央値は 2 番目と 3 番目の間、 90 パーセンタイルは 3 番目と 4 番目の間 (位置 2.7) を線形補間する.
+		{"even number of samples", seconds(4, 1, 3, 2), LeadTimeStats{Count: 4, Min: time.Second, Max: 4 * time.Second, Mean: 2500 * time.Millisecond, Median: 2500 * time.Millisecond, P90: 3700 * time.Millisecond}},
+		{"negative lead times", seconds(-60, 30, 120), LeadTimeStats{Count: 3, Min: -60 * time.Second, Max: 120 * time.Second, Mean: 30 * time.Second, Median: 30 * time.Second, P90: 102 * time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append([]time.Duration(nil), tt.leadTimes...)
+			if got := LeadTimeStatistics(tt.leadTimes); got != tt.want {
+				t.Errorf("LeadTimeStatistics() = %+v, want %+v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.leadTimes, input) {
+				t.Errorf("LeadTimeStatistics() modified the input: %v, want %v", tt.leadTimes, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package userquake
+
+// englishRegions は地方名の英語表記.
+var englishRegions = map[string]string{
+	"未設定": "Not set",
+	"不明":  "Unknown",
+	"外国":  "Overseas",
+	"北海道": "Hokkaido",
+	"東北":  "Tohoku",
+	"関東":  "Kanto",
+	"北陸":  "Hokuriku",
+	"甲信":  "Koshin",
+	"東海":  "Tokai",
+	"近畿":  "Kinki",
+	"中国":  "Chugoku",
+	"四国":  "Shikoku",
+	"九州北": "Northern Kyushu",
+	"九州南": "Southern Kyushu",
+	"沖縄":  "Okinawa",
+}
+
+// englishPrefectures は都道府県名 (地域一覧の表記) のローマ字表記.
+var englishPrefectures = map[string]string{
+	"未設定": "Not set",
+	"不明":  "Unknown",
+	"外国":  "Overseas",
+	"北海道": "Hokkaido",
+	"青森":  "Aomori",
+	"岩手":  "Iwate",
+	"宮城":  "Miyagi",
+	"秋田":  "Akita",
+	"山形":  "Yamagata",
+	"福島":  "Fukushima",
+	"茨城":  "Ibaraki",
+	"栃木":  "Tochigi",
+	"群馬":  "Gunma",
+	"埼玉":  "Saitama",
+	"千葉":  "Chiba",
+	"東京":  "Tokyo",
+	"神奈川": "Kanagawa",
+	"新潟":  "Niigata",
+	"富山":  "Toyama",
+	"石川":  "Ishikawa",
+	"福井":  "Fukui",
+	"山梨":  "Yamanashi",
+	"長野":  "Nagano",
+	"岐阜":  "Gifu",
+	"静岡":  "Shizuoka",
+	"愛知":  "Aichi",
+	"三重":  "Mie",
+	"滋賀":  "Shiga",
+	"京都":  "Kyoto",
+	"大阪":  "Osaka",
+	"兵庫":  "Hyogo",
+	"奈良":  "Nara",
+	"和歌山": "Wakayama",
+	"鳥取":  "Tottori",
+	"島根":  "Shimane",
+	"岡山":  "Okayama",
+	"広島":  "Hiroshima",
+	"山口":  "Yamaguchi",
+	"徳島":  "Tokushima",
+	"香川":  "Kagawa",
+	"愛媛":  "Ehime",
+	"高知":  "Kochi",
+	"福岡":  "Fukuoka",
+	"佐賀":  "Saga",
+	"長崎":  "Nagasaki",
+	"熊本":  "Kumamoto",
+	"大分":  "Oita",
+	"宮崎":  "Miyazaki",
+	"鹿児島": "Kagoshima",
+	"沖縄":  "Okinawa",
+}
+
+// englishNames は地域名の英語表記. 地名はローマ字で、方角などは英語で表す.
+var englishNames = map[int]string{
+	0:   "Area not set",
+	901: "Unknown area",
+	905: "Outside Japan",
+	10:  "Ishikari, Hokkaido",
+	15:  "Oshima, Hokkaido",
+	20:  "Hiyama, Hokkaido",
+	25:  "Shiribeshi, Hokkaido",
+	30:  "Sorachi, Hokkaido",
+	35:  "Kamikawa, Hokkaido",
+	40:  "Rumoi, Hokkaido",
+	45:  "Soya, Hokkaido",
+	50:  "Abashiri, Hokkaido",
+	55:  "Iburi, Hokkaido",
+	60:  "Hidaka, Hokkaido",
+	65:  "Tokachi, Hokkaido",
+	70:  "Kushiro, Hokkaido",
+	75:  "Nemuro, Hokkaido",
+	100: "Tsugaru, Aomori",
+	105: "Sanpachi-Kamikita, Aomori",
+	106: "Shimokita, Aomori",
+	110: "Northern Coast, Iwate",
+	111: "Southern Coast, Iwate",
+	115: "Inland, Iwate",
+	120: "Northern Miyagi",
+	125: "Southern Miyagi",
+	130: "Coast, Akita",
+	135: "Inland, Akita",
+	140: "Shonai, Yamagata",
+	141: "Mogami, Yamagata",
+	142: "Murayama, Yamagata",
+	143: "Okitama, Yamagata",
+	150: "Nakadori, Fukushima",
+	151: "Hamadori, Fukushima",
+	152: "Aizu, Fukushima",
+	200: "Northern Ibaraki",
+	205: "Southern Ibaraki",
+	210: "Northern Tochigi",
+	215: "Southern Tochigi",
+	220: "Northern Gunma",
+	225: "Southern Gunma",
+	230: "Northern Saitama",
+	231: "Southern Saitama",
+	232: "Chichibu, Saitama",
+	240: "Northeastern Chiba",
+	241: "Northwestern Chiba",
+	242: "Southern Chiba",
+	250: "Tokyo",
+	255: "Northern Izu Islands",
+	260: "Southern Izu Islands",
+	265: "Ogasawara",
+	270: "Eastern Kanagawa",
+	275: "Western Kanagawa",
+	300: "Joetsu, Niigata",
+	301: "Chuetsu, Niigata",
+	302: "Kaetsu, Niigata",
+	305: "Sado, Niigata",
+	310: "Eastern Toyama",
+	315: "Western Toyama",
+	320: "Noto, Ishikawa",
+	325: "Kaga, Ishikawa",
+	330: "Reihoku, Fukui",
+	335: "Reinan, Fukui",
+	340: "Eastern Yamanashi",
+	345: "Central and Western Yamanashi",
+	350: "Northern Nagano",
+	351: "Central Nagano",
+	355: "Southern Nagano",
+	400: "Hida, Gifu",
+	405: "Mino, Gifu",
+	410: "Izu, Shizuoka",
+	411: "Eastern Shizuoka",
+	415: "Central Shizuoka",
+	416: "Western Shizuoka",
+	420: "Eastern Aichi",
+	425: "Western Aichi",
+	430: "Northern and Central Mie",
+	435: "Southern Mie",
+	440: "Northern Shiga",
+	445: "Southern Shiga",
+	450: "Northern Kyoto",
+	455: "Southern Kyoto",
+	460: "Northern Osaka",
+	465: "Southern Osaka",
+	470: "Northern Hyogo",
+	475: "Southern Hyogo",
+	480: "Nara",
+	490: "Northern Wakayama",
+	495: "Southern Wakayama",
+	500: "Eastern Tottori",
+	505: "Central and Western Tottori",
+	510: "Eastern Shimane",
+	515: "Western Shimane",
+	514: "Oki, Shimane",
+	520: "Northern Okayama",
+	525: "Southern Okayama",
+	530: "Northern Hiroshima",
+	535: "Southern Hiroshima",
+	540: "Northern Yamaguchi",
+	545: "Central and Eastern Yamaguchi",
+	541: "Western Yamaguchi",
+	550: "Northern Tokushima",
+	555: "Southern Tokushima",
+	560: "Kagawa",
+	570: "Toyo, Ehime",
+	575: "Chuyo, Ehime",
+	576: "Nanyo, Ehime",
+	580: "Eastern Kochi",
+	581: "Central Kochi",
+	582: "Western Kochi",
+	600: "Fukuoka, Fukuoka",
+	601: "Kitakyushu, Fukuoka",
+	602: "Chikuho, Fukuoka",
+	605: "Chikugo, Fukuoka",
+	610: "Northern Saga",
+	615: "Southern Saga",
+	620: "Northern Nagasaki",
+	625: "Southern Nagasaki",
+	630: "Iki and Tsushima, Nagasaki",
+	635: "Goto, Nagasaki",
+	640: "Aso, Kumamoto",
+	641: "Kumamoto, Kumamoto",
+	645: "Kuma, Kumamoto",
+	646: "Amakusa and Ashikita, Kumamoto",
+	650: "Northern Oita",
+	651: "Central Oita",
+	655: "Western Oita",
+	656: "Southern Oita",
+	660: "Northern Plains, Miyazaki",
+	661: "Northern Mountains, Miyazaki",
+	665: "Southern Plains, Miyazaki",
+	666: "Southern Mountains, Miyazaki",
+	670: "Satsuma, Kagoshima",
+	675: "Osumi, Kagoshima",
+	680: "Tanegashima and Yakushima",
+	685: "Amami, Kagoshima",
+	700: "Northern Okinawa Island",
+	701: "Central and Southern Okinawa Island",
+	702: "Kumejima, Okinawa",
+	710: "Daito Islands, Okinawa",
+	706: "Miyakojima, Okinawa",
+	705: "Yaeyama, Okinawa",
+}
+
+// EnglishRegion は地方名の英語表記を返す.
+func EnglishRegion(region string) (string, bool) {
+	name, ok := englishRegions[region]
+	return name, ok
+}
+
+// EnglishPrefecture は都道府県名のローマ字表記を返す. "東京都" などの表記も受け付ける.
+func EnglishPrefecture(prefecture string) (string, bool) {
+	if name, ok := englishPrefectures[prefecture]; ok {
+		return name, true
+	}
+	name, ok := englishPrefectures[NormalizePrefecture(prefecture)]
+	return name, ok
+}
+
+// EnglishName は地域コードに対応する地域名の英語表記を返す.
+func EnglishName(code int) (string, bool) {
+	name, ok := englishNames[code]
+	return name, ok
+}
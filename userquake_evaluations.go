@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// beta3LevelConfidences は P2P地震情報 Beta3 における信頼度レベルと、評価結果の confidence の対応.
+// confidence はレベルの順に大きくなるとは限らない.
+var beta3LevelConfidences = map[int64]float64{
+	1: 0.97015,
+	2: 0.96774,
+	3: 0.97024,
+	4: 0.98052,
+}
+
+type UserquakeEvaluationParam struct {
+	Offset        int64   `form:"offset"`
+	Limit         int64   `form:"limit"`
+	MinConfidence float64 `form:"min_confidence"`
+	MinLevel      int64   `form:"min_level"`
+	SinceTime     string  `form:"since_time"`
+	UntilTime     string  `form:"until_time"`
+	Area          *int64  `form:"area"`
+	All           bool    `form:"all"`
+	Lang          string  `form:"lang"`
+}
+
+func (p *UserquakeEvaluationParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseOptionalTimeRange(p.SinceTime, p.UntilTime)
+	if p.Area != nil {
+		if _, ok := userquake.Areas.ByCode(int(*p.Area)); !ok {
+			invalidParams = append(invalidParams, InvalidParam{Name: "area", Reason: "must be an area code listed in /areas", Rule: "area", Value: strconv.FormatInt(*p.Area, 10)})
+		}
+	}
+	return invalidParams
+}
+
+// timeRange は評価結果の開始日時を絞り込む期間を返す. 省略した側はゼロ値とする.
+func (p *UserquakeEvaluationParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseOptionalTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+func searchUserquakeEvaluations(c *gin.Context) {
+	var evaluationParam UserquakeEvaluationParam
+	if !bindQuery(c, &evaluationParam) {
+		return
+	}
+	since, until := evaluationParam.timeRange()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	cur, err := historyCollection.Aggregate(ctx, userquakeEvaluationPipeline(evaluationParam, since, until))
+	if err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	items := make([]bson.M, 0)
+	if err := cur.All(ctx, &items); err != nil {
+		log.Printf("aggregate error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	for _, item := range items {
+		cleanJmaRecord(item)
+		if evaluationParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
+	}
+
+	respond(c, 200, items, &pb.UserquakeEvaluations{})
+}
+
+// userquakeEvaluationPipeline は評価結果を検索する集計パイプラインを返す.
+// 同じ started_at の評価結果は更新されていくため、デフォルトでは最新の評価結果のみを対象に絞り込む.
+func userquakeEvaluationPipeline(evaluationParam UserquakeEvaluationParam, since time.Time, until time.Time) []bson.D {
+	match := bson.D{{"code", 9611}}
+	startedAt := bson.D{}
+	if !since.IsZero() {
+		startedAt = append(startedAt, bson.E{"$gte", since.Format(recordTimeLayout)})
+	}
+	if !until.IsZero() {
+		startedAt = append(startedAt, bson.E{"$lte", until.Format(recordTimeLayout)})
+	}
+	if len(startedAt) > 0 {
+		match = append(match, bson.E{"started_at", startedAt})
+	}
+
+	pipeline := []bson.D{
+		{{"$match", match}},
+		{{"$sort", bson.D{{"started_at", -1}, {"updated_at", -1}, {"time", -1}}}},
+	}
+	if !evaluationParam.All {
+		pipeline = append(pipeline,
+			bson.D{{"$group", bson.D{{"_id", "$started_at"}, {"latest", bson.D{{"$first", "$$ROOT"}}}}}},
+			bson.D{{"$replaceRoot", bson.D{{"newRoot", "$latest"}}}},
+		)
+	}
+
+	filters := bson.D{}
+	confidence := bson.D{}
+	if evaluationParam.MinConfidence != 0.0 {
+		confidence = append(confidence, bson.E{"$gte", evaluationParam.MinConfidence})
+	}
+	if evaluationParam.MinLevel != 0 {
+		var confidences []float64
+		for level, c := range beta3LevelConfidences {
+			if level >= evaluationParam.MinLevel {
+				confidences = append(confidences, c)
+			}
+		}
+		confidence = append(confidence, bson.E{"$in", confidences})
+	}
+	if len(confidence) > 0 {
+		filters = append(filters, bson.E{"confidence", confidence})
+	}
+	if evaluationParam.Area != nil {
+		filters = append(filters, bson.E{"area_confidences." + strconv.FormatInt(*evaluationParam.Area, 10), bson.D{{"$exists", true}}})
+	}
+	if len(filters) > 0 {
+		pipeline = append(pipeline, bson.D{{"$match", filters}})
+	}
+
+	limit := evaluationParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	pipeline = append(pipeline,
+		bson.D{{"$sort", bson.D{{"started_at", -1}, {"updated_at", -1}, {"time", -1}}}},
+		bson.D{{"$skip", evaluationParam.Offset}},
+		bson.D{{"$limit", limit}},
+	)
+	return pipeline
+}
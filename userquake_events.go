@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const recordTimeLayout = "2006/01/02 15:04:05.000"
+
+type UserquakeEventParam struct {
+	Offset    int64  `form:"offset"`
+	Limit     int64  `form:"limit"`
+	SinceTime string `form:"since_time"`
+	UntilTime string `form:"until_time"`
+	Lang      string `form:"lang"`
+}
+
+func (p *UserquakeEventParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseTimeRange(p.SinceTime, p.UntilTime)
+	return invalidParams
+}
+
+// timeRange は検索する期間を返す. 省略した場合は直近 24 時間とする.
+func (p *UserquakeEventParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+func getUserquakeEvents(c *gin.Context) {
+	var eventParam UserquakeEventParam
+	if !bindQuery(c, &eventParam) {
+		return
+	}
+	since, until := eventParam.timeRange()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	clusters, err := findUserquakeClusters(ctx, since, until)
+	if err != nil {
+		log.Printf("userquake cluster error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	quakes, err := findCorrelationQuakes(ctx, since.Add(-userquakeCorrelationOptions.Before), until.Add(userquakeCorrelationOptions.After))
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	events := make([]primitive.M, 0, len(clusters))
+	for _, cluster := range clusters {
+		event := userquakeEvent(cluster)
+		event["quakes"] = quakeMatches(userquakeCorrelationOptions.Correlate(cluster, quakes))
+		if eventParam.Lang == langEnglish {
+			localizeUserquakeEvent(event)
+		}
+		events = append(events, event)
+	}
+
+	// 新しい順に返却する.
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i]["started_at"].(string) > events[j]["started_at"].(string)
+	})
+
+	limit := eventParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	start := eventParam.Offset
+	if start > int64(len(events)) {
+		start = int64(len(events))
+	}
+	end := start + limit
+	if end > int64(len(events)) {
+		end = int64(len(events))
+	}
+
+	respond(c, 200, events[start:end], &pb.UserquakeEvents{})
+}
+
+// findUserquakeClusters は期間内の地震感知情報をまとめる.
+func findUserquakeClusters(ctx context.Context, since time.Time, until time.Time) ([]userquake.Cluster, error) {
+	filters := bson.D{
+		{"code", 561},
+		{"time", bson.D{{"$gte", since.Format(recordTimeLayout)}, {"$lte", until.Format(recordTimeLayout)}}},
+	}
+	cur, err := historyCollection.Find(ctx, filters, options.Find().SetSort(bson.D{{"time", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var clusters []userquake.Cluster
+	clusterer := userquake.NewClusterer(userquakeClusterOptions)
+	for cur.Next(ctx) {
+		var result bson.M
+		if err := cur.Decode(&result); err != nil {
+			return nil, err
+		}
+
+		t, err := parseJSTTime(recordString(result, "time"))
+		if err != nil {
+			return nil, err
+		}
+		area, _ := recordInt(result, "area")
+
+		clusters = append(clusters, clusterer.Add(userquake.Report{Time: t, Area: area, Data: result})...)
+	}
+	clusters = append(clusters, clusterer.Flush()...)
+	return clusters, cur.Err()
+}
+
+// userquakeEvent は地震感知情報のまとまりを、開始・終了日時と地方・都道府県・地域ごとの件数にまとめる.
+func userquakeEvent(cluster userquake.Cluster) primitive.M {
+	first := cluster.Reports[0].Data.(bson.M)
+	last := cluster.Reports[len(cluster.Reports)-1].Data.(bson.M)
+
+	areaCodes := map[string]int{}
+	for code, count := range cluster.AreaCodes() {
+		areaCodes[strconv.Itoa(code)] = count
+	}
+
+	event := analyzeCluster(cluster)
+	delete(event, "time")
+	delete(event, "code")
+	event["id"] = recordID(first)
+	event["started_at"] = recordString(first, "time")
+	event["ended_at"] = recordString(last, "time")
+	event["area_codes"] = areaCodes
+	return event
+}
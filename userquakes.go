@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/p2pquake/web-api-v2/pb"
+	"github.com/p2pquake/web-api-v2/userquake"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// minuteTimeLayout は地震感知情報を分ごとに集計するときの日時の形式. 受信日時の先頭 16 文字にあたる.
+const minuteTimeLayout = "2006/01/02 15:04"
+
+type UserquakeParam struct {
+	Offset     int64  `form:"offset"`
+	Limit      int64  `form:"limit"`
+	SinceTime  string `form:"since_time"`
+	UntilTime  string `form:"until_time"`
+	Area       *int64 `form:"area"`
+	Prefecture string `form:"prefecture"`
+	Region     string `form:"region"`
+	Aggregate  string `form:"aggregate"`
+	Lang       string `form:"lang"`
+}
+
+func (p *UserquakeParam) validateCrossFields() []InvalidParam {
+	_, _, invalidParams := parseTimeRange(p.SinceTime, p.UntilTime)
+	if p.Area != nil {
+		if _, ok := userquake.Areas.ByCode(int(*p.Area)); !ok {
+			invalidParams = append(invalidParams, InvalidParam{Name: "area", Reason: "must be an area code listed in /areas", Rule: "area", Value: strconv.FormatInt(*p.Area, 10)})
+		}
+	}
+	if p.Prefecture != "" && len(userquake.Areas.ByPrefecture(p.Prefecture)) == 0 {
+		invalidParams = append(invalidParams, InvalidParam{Name: "prefecture", Reason: "must be a prefecture listed in /areas", Rule: "prefecture", Value: p.Prefecture})
+	}
+	if p.Region != "" && len(userquake.Areas.ByRegion(p.Region)) == 0 {
+		invalidParams = append(invalidParams, InvalidParam{Name: "region", Reason: "must be a region listed in /areas", Rule: "region", Value: p.Region})
+	}
+	return invalidParams
+}
+
+// timeRange は検索する期間を返す. 省略した場合は直近 24 時間とする.
+func (p *UserquakeParam) timeRange() (time.Time, time.Time) {
+	since, until, _ := parseTimeRange(p.SinceTime, p.UntilTime)
+	return since, until
+}
+
+// areaCodes は area, prefecture, region のすべてに当てはまる地域コードを返す. いずれも指定されていなければ nil を返す.
+func (p *UserquakeParam) areaCodes() []int {
+	if p.Area == nil && p.Prefecture == "" && p.Region == "" {
+		return nil
+	}
+
+	codes := []int{}
+	for _, area := range filterAreas(AreaParam{Region: p.Region, Prefecture: p.Prefecture}) {
+		if p.Area != nil && int64(area.Code) != *p.Area {
+			continue
+		}
+		codes = append(codes, area.Code)
+	}
+	return codes
+}
+
+func searchUserquakes(c *gin.Context) {
+	var userquakeParam UserquakeParam
+	if !bindQuery(c, &userquakeParam) {
+		return
+	}
+	since, until := userquakeParam.timeRange()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	filters := bson.D{
+		{"code", 561},
+		{"time", bson.D{{"$gte", since.Format(recordTimeLayout)}, {"$lte", until.Format(recordTimeLayout)}}},
+	}
+	if codes := userquakeParam.areaCodes(); codes != nil {
+		filters = append(filters, bson.E{"area", bson.D{{"$in", codes}}})
+	}
+
+	limit := userquakeParam.Limit
+	if limit == 0 {
+		limit = 10
+	}
+	offset := userquakeParam.Offset
+
+	if userquakeParam.Aggregate == "minute" {
+		counts, err := countUserquakesPerMinute(ctx, filters, offset, limit)
+		if err != nil {
+			log.Printf("aggregate error: %v\n", err)
+			respondProblem(c, 500, "database error")
+			return
+		}
+		respond(c, 200, counts, &pb.UserquakeCounts{})
+		return
+	}
+
+	options := options.FindOptions{Limit: &limit, Skip: &offset, Sort: bson.D{{"time", -1}}}
+	cur, err := historyCollection.Find(ctx, filters, &options)
+	if err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+	defer cur.Close(ctx)
+
+	items := make([]bson.M, 0)
+	if err := cur.All(ctx, &items); err != nil {
+		log.Printf("find error: %v\n", err)
+		respondProblem(c, 500, "database error")
+		return
+	}
+
+	for _, item := range items {
+		cleanJmaRecord(item)
+		if userquakeParam.Lang == langEnglish {
+			localizeRecord(item)
+		}
+	}
+
+	respond(c, 200, items, &pb.Userquakes{})
+}
+
+// countUserquakesPerMinute は地震感知情報の件数を受信日時の分ごとに、新しい順に返す. 件数のない分は含めない.
+func countUserquakesPerMinute(ctx context.Context, filters bson.D, offset int64, limit int64) ([]bson.M, error) {
+	pipeline := []bson.D{
+		{{"$match", filters}},
+		{{"$group", bson.D{{"_id", bson.D{{"$substrBytes", bson.A{"$time", 0, len(minuteTimeLayout)}}}}, {"count", bson.D{{"$sum", 1}}}}}},
+		{{"$sort", bson.D{{"_id", -1}}}},
+		{{"$skip", offset}},
+		{{"$limit", limit}},
+		{{"$project", bson.D{{"_id", 0}, {"time", "$_id"}, {"count", 1}}}},
+	}
+	cur, err := historyCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	counts := make([]bson.M, 0)
+	if err := cur.All(ctx, &counts); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}